@@ -0,0 +1,61 @@
+// Command token-keys reports which keys stored OAuth tokens and CalDAV passwords are encrypted with,
+// run it after rotating Secrets.TokenKeyID to see when retired keys can be removed.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/app/container"
+	"github.com/hexarchy/itmo-calendar/internal/config"
+	configcore "github.com/hexarchy/itmo-calendar/pkg/config"
+)
+
+func main() {
+	cfg := &config.Config{}
+	err := configcore.Init(cfg)
+	if err != nil {
+		log.Fatal("Fail to load config: ", err)
+	}
+
+	ctx := context.Background()
+	c, err := container.New(ctx, cfg, zap.NewNop())
+	if err != nil {
+		log.Fatal("Fail to create container: ", err)
+	}
+	defer c.Infra.Postgres.Close()
+
+	usage, err := c.UseCases.ReportTokenKeys.Execute(ctx)
+	if err != nil {
+		log.Fatal("Fail to report token keys: ", err)
+	}
+
+	ids := make([]string, 0, len(usage.Rows))
+	for id := range usage.Rows {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	fmt.Printf("current key: %s\n", keyName(usage.Current))
+	for _, id := range ids {
+		fmt.Printf("%-16s %d\n", keyName(id), usage.Rows[id])
+	}
+	fmt.Printf("rows on old keys: %d\n", usage.Stale())
+
+	if usage.Stale() > 0 {
+		os.Exit(1)
+	}
+}
+
+func keyName(id string) string {
+	if id == "" {
+		return "(legacy)"
+	}
+
+	return id
+}
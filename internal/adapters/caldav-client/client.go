@@ -0,0 +1,219 @@
+package caldavclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// _nonPublicPrefixes are special-purpose ranges netip does not classify, see RFC 6890.
+var _nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("fec0::/10"),
+}
+
+// Client writes calendar objects into users' CalDAV collections.
+type Client struct {
+	client       *http.Client
+	resolver     *net.Resolver
+	allowPrivate bool
+}
+
+// New creates new Client. Unless allowPrivate is set it only connects to public addresses,
+// so user-supplied URLs can not reach loopback, private networks or cloud metadata endpoints.
+func New(timeout time.Duration, allowPrivate bool) *Client {
+	c := &Client{
+		resolver:     net.DefaultResolver,
+		allowPrivate: allowPrivate,
+	}
+
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: c.control,
+	}
+	c.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// No proxy, the address checked on dialing has to be the server's own.
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			ForceAttemptHTTP2:   true,
+		},
+	}
+
+	return c
+}
+
+// CheckHost resolves the host and fails with entities.ErrForbiddenAddress if any of its
+// addresses is not public. Connections are checked again on dialing, so a host that
+// resolves differently later on is still refused.
+func (c *Client) CheckHost(ctx context.Context, host string) error {
+	if c.allowPrivate {
+		return nil
+	}
+
+	addrs, err := c.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return errors.Wrapf(err, "resolve %s", host)
+	}
+
+	for _, addr := range addrs {
+		if !publicAddr(addr) {
+			return errors.Wrapf(entities.ErrForbiddenAddress, "%s resolves to %s", host, addr)
+		}
+	}
+
+	return nil
+}
+
+// Put writes a calendar object and returns its new ETag. With create set the write only
+// creates a missing resource, otherwise it only replaces the ifMatch version of it.
+// A resource whose ETag is unknown may have been edited since, it fails with entities.ErrRemoteConflict.
+func (c *Client) Put(ctx context.Context, target entities.CalDavTarget, href, data, ifMatch string, create bool) (string, error) {
+	if !create && ifMatch == "" {
+		return "", entities.ErrRemoteConflict
+	}
+
+	req, err := c.newRequest(ctx, target, http.MethodPut, href, strings.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "text/calendar; charset=utf-8")
+	if create {
+		req.Header.Set("If-None-Match", "*")
+	} else {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+
+	// A server that altered the data does not return an ETag, the stored version has to be asked for.
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		etag, err = c.etag(ctx, target, href)
+		if err != nil {
+			return "", errors.Wrap(err, "get etag")
+		}
+	}
+
+	return etag, nil
+}
+
+// Delete removes a calendar object if it is still at the given ETag.
+// A resource whose ETag is unknown fails with entities.ErrRemoteConflict.
+func (c *Client) Delete(ctx context.Context, target entities.CalDavTarget, href, ifMatch string) error {
+	if ifMatch == "" {
+		return entities.ErrRemoteConflict
+	}
+
+	req, err := c.newRequest(ctx, target, http.MethodDelete, href, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("If-Match", ifMatch)
+
+	_, err = c.do(req)
+
+	return err
+}
+
+func (c *Client) etag(ctx context.Context, target entities.CalDavTarget, href string) (string, error) {
+	req, err := c.newRequest(ctx, target, http.MethodHead, href, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Header.Get("ETag"), nil
+}
+
+func (c *Client) newRequest(ctx context.Context, target entities.CalDavTarget, method, href string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, href, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "create request")
+	}
+	if target.Username != "" || target.Password != "" {
+		req.SetBasicAuth(target.Username, target.Password)
+	}
+
+	return req, nil
+}
+
+// do executes the request and maps failed statuses to errors,
+// 412 to entities.ErrRemoteConflict and 404 to entities.ErrRemoteNotFound.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %s", req.Method, req.URL.Redacted())
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusPreconditionFailed:
+		return nil, entities.ErrRemoteConflict
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, entities.ErrRemoteNotFound
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return nil, fmt.Errorf("%s %s: unexpected status %d", req.Method, req.URL.Redacted(), resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+// control refuses connections to non-public addresses, it runs after name resolution
+// for every address dialed.
+func (c *Client) control(_, address string, _ syscall.RawConn) error {
+	if c.allowPrivate {
+		return nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "parse address %s", address)
+	}
+	if !publicAddr(addrPort.Addr()) {
+		return errors.Wrapf(entities.ErrForbiddenAddress, "dial %s", address)
+	}
+
+	return nil
+}
+
+// publicAddr reports whether the address is globally routable.
+func publicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range _nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
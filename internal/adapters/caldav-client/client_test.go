@@ -0,0 +1,97 @@
+package caldavclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+func TestPublicAddr(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"8.8.8.8":                true,
+		"2a00:1450:4010::8a":     true,
+		"127.0.0.1":              false,
+		"10.0.0.1":               false,
+		"172.16.5.4":             false,
+		"192.168.1.1":            false,
+		"169.254.169.254":        false,
+		"100.64.0.1":             false,
+		"0.0.0.0":                false,
+		"255.255.255.255":        false,
+		"::1":                    false,
+		"fe80::1":                false,
+		"fd00:ec2::254":          false,
+		"::ffff:127.0.0.1":       false,
+		"::ffff:169.254.169.254": false,
+	} {
+		assert.Equal(t, public, publicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	ctx := context.Background()
+	c := New(time.Second, false)
+
+	assert.ErrorIs(t, c.CheckHost(ctx, "127.0.0.1"), entities.ErrForbiddenAddress)
+	assert.ErrorIs(t, c.CheckHost(ctx, "localhost"), entities.ErrForbiddenAddress)
+	assert.ErrorIs(t, c.CheckHost(ctx, "169.254.169.254"), entities.ErrForbiddenAddress)
+	assert.NoError(t, c.CheckHost(ctx, "203.0.113.7"))
+
+	assert.NoError(t, New(time.Second, true).CheckHost(ctx, "127.0.0.1"))
+}
+
+func TestDialRefusesNonPublicAddresses(t *testing.T) {
+	ctx := context.Background()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	// A host that passed the check and resolves to loopback later on is still refused.
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	href := "http://localhost:" + u.Port() + "/calendars/student/a.ics"
+	target := entities.CalDavTarget{URL: server.URL, Username: "student", Password: "secret"}
+
+	_, err = New(time.Second, false).Put(ctx, target, href, "A1", "", true)
+	assert.ErrorIs(t, err, entities.ErrForbiddenAddress)
+	assert.Zero(t, requests)
+
+	etag, err := New(time.Second, true).Put(ctx, target, href, "A1", "", true)
+	require.NoError(t, err)
+	assert.Equal(t, `"v1"`, etag)
+	assert.Equal(t, 1, requests)
+}
+
+func TestUnknownETag(t *testing.T) {
+	ctx := context.Background()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := New(time.Second, true)
+	target := entities.CalDavTarget{URL: server.URL}
+	href := server.URL + "/calendars/student/a.ics"
+
+	_, err := c.Put(ctx, target, href, "A2", "", false)
+	assert.ErrorIs(t, err, entities.ErrRemoteConflict)
+	assert.ErrorIs(t, c.Delete(ctx, target, href, ""), entities.ErrRemoteConflict)
+	assert.Zero(t, requests)
+}
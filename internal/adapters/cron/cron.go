@@ -2,6 +2,7 @@ package cron
 
 import (
 	"context"
+	"time"
 
 	"github.com/hexarchy/itmo-calendar/pkg/rabbitmq"
 
@@ -38,9 +39,11 @@ func (a *Adapter) SendCronTask(ctx context.Context) error {
 
 func (a *Adapter) ScheduleSending(ctx context.Context, isus []int64) error {
 	payload := struct {
-		ISUs []int64 `json:"isus"`
+		ISUs        []int64   `json:"isus"`
+		ScheduledAt time.Time `json:"scheduled_at"`
 	}{
-		ISUs: isus,
+		ISUs:        isus,
+		ScheduledAt: time.Now(),
 	}
 
 	msg, err := rabbitmq.NewMessage(payload, nil)
@@ -92,13 +92,13 @@ func (c *Client) buildRequest(ctx context.Context, token string, from, to time.T
 func (c *Client) executeRequest(req *http.Request) (*scheduleResponse, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "send request")
+		return nil, errors.Wrapf(entities.ErrUpstreamUnavailable, "send request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, errors.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+		return nil, statusError(resp.StatusCode, body)
 	}
 
 	var response scheduleResponse
@@ -125,3 +125,17 @@ func (c *Client) transformResponse(response *scheduleResponse) ([]entities.DaySc
 
 	return result, nil
 }
+
+// statusError classifies an unexpected response status of the schedule API.
+func statusError(status int, body []byte) error {
+	switch {
+	case status == http.StatusUnauthorized:
+		return errors.Wrapf(entities.ErrTokenExpired, "status %d", status)
+	case status == http.StatusTooManyRequests:
+		return errors.Wrapf(entities.ErrRateLimited, "status %d", status)
+	case status >= http.StatusInternalServerError:
+		return errors.Wrapf(entities.ErrUpstreamUnavailable, "status %d: %s", status, body)
+	}
+
+	return errors.Errorf("unexpected status code: %d, body: %s", status, body)
+}
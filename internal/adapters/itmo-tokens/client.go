@@ -5,20 +5,26 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
 )
 
 // Client is ITMO OAuth tokens client.
 type Client struct {
 	httpClient  *http.Client
+	loginClient *http.Client
 	clientID    string
 	redirectURI string
+	callbackURI string
 	providerURL string
 	logger      *zap.Logger
 }
 
-// New creates a new ITMO OAuth tokens client.
-func New(clientID, redirectURI, providerURL string, logger *zap.Logger) *Client {
+// New creates a new ITMO OAuth tokens client. The redirect URI is used by the password flow,
+// the callback URI is where ITMO ID sends browsers back to after a login.
+func New(clientID, redirectURI, callbackURI, providerURL string, logger *zap.Logger) *Client {
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: true,
@@ -30,11 +36,39 @@ func New(clientID, redirectURI, providerURL string, logger *zap.Logger) *Client
 		Timeout:   30 * time.Second,
 	}
 
+	// loginClient stops at redirects, the login reads codes and cookies from them.
+	loginClient := &http.Client{
+		Transport: tr,
+		Timeout:   30 * time.Second,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
 	return &Client{
 		httpClient:  httpClient,
+		loginClient: loginClient,
 		clientID:    clientID,
 		redirectURI: redirectURI,
+		callbackURI: callbackURI,
 		providerURL: providerURL,
 		logger:      logger.With(zap.String("component", "itmo_tokens_client")),
 	}
 }
+
+// unavailable marks a request that did not reach ITMO ID.
+func unavailable(err error, message string) error {
+	return errors.Wrapf(entities.ErrUpstreamUnavailable, "%s: %v", message, err)
+}
+
+// statusError classifies an unexpected response status of ITMO ID.
+func statusError(status int, body []byte) error {
+	switch {
+	case status == http.StatusTooManyRequests:
+		return errors.Wrapf(entities.ErrRateLimited, "status %d", status)
+	case status >= http.StatusInternalServerError:
+		return errors.Wrapf(entities.ErrUpstreamUnavailable, "status %d: %s", status, body)
+	}
+
+	return errors.Errorf("unexpected status %d: %s", status, body)
+}
@@ -0,0 +1,81 @@
+package itmotokens
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// StartLogin returns the ITMO ID URL to send the browser to and the PKCE code verifier,
+// which has to be kept on the server until the callback with the state arrives.
+func (c *Client) StartLogin(state string) (string, string, error) {
+	codeVerifier, err := generateCodeVerifier()
+	if err != nil {
+		return "", "", errors.Wrap(err, "generate code verifier")
+	}
+
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.clientID},
+		"redirect_uri":          {c.callbackURI},
+		"scope":                 {"openid"},
+		"state":                 {state},
+		"code_challenge_method": {"S256"},
+		"code_challenge":        {getCodeChallenge(codeVerifier)},
+	}
+
+	return c.providerURL + "/protocol/openid-connect/auth?" + params.Encode(), codeVerifier, nil
+}
+
+// Exchange exchanges the code of a browser login for tokens of the user who logged in.
+func (c *Client) Exchange(ctx context.Context, code, codeVerifier string) (*entities.UserTokens, error) {
+	tokens, err := c.exchangeCode(ctx, code, codeVerifier, c.callbackURI)
+	if err != nil {
+		return nil, errors.Wrap(err, "exchange code")
+	}
+
+	tokens.ISU, err = isuFromToken(tokens.AccessToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "read isu")
+	}
+
+	return tokens, nil
+}
+
+// isuFromToken reads the isu claim of an access token. The signature is not checked,
+// the token comes straight from the token endpoint.
+func isuFromToken(token string) (int64, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, errors.New("access token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return 0, errors.Wrap(err, "decode payload")
+	}
+
+	var claims struct {
+		ISU json.Number `json:"isu"`
+	}
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return 0, errors.Wrap(err, "unmarshal claims")
+	}
+	if claims.ISU == "" {
+		return 0, errors.New("no isu claim")
+	}
+
+	isu, err := claims.ISU.Int64()
+	if err != nil {
+		return 0, errors.Wrap(err, "parse isu claim")
+	}
+
+	return isu, nil
+}
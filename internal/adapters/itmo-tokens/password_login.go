@@ -0,0 +1,311 @@
+package itmotokens
+
+import (
+	"context"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// _maxRedirects limits redirects followed between two login pages.
+const _maxRedirects = 5
+
+var (
+	// ErrInvalidCredentials is returned when ITMO ID rejects the ISU or password.
+	ErrInvalidCredentials = entities.ErrInvalidCredentials
+	// ErrUnexpectedPage is returned when the login stops at a page that is not supported.
+	ErrUnexpectedPage = errors.New("unexpected login page")
+)
+
+var (
+	_loginFormRe = regexp.MustCompile(`(?s)<form[^>]*\s+id="kc-form-login"[^>]*\s+action="([^"]+)"`)
+	_formRe      = regexp.MustCompile(`(?s)<form[^>]*\s+action="([^"]+)"`)
+	_hiddenRe    = regexp.MustCompile(`<input[^>]+type="hidden"[^>]+name="([^"]+)"[^>]+value="([^"]*)"[^>]*>`)
+	_feedbackRe  = regexp.MustCompile(`(?s)<span[^>]*class="[^"]*(?:invalid-feedback|kc-feedback-text)[^"]*"[^>]*>(.*?)</span>`)
+)
+
+// page is a response of the login, read and closed.
+type page struct {
+	status   int
+	location string
+	body     string
+	url      string
+}
+
+// Get performs OAuth2 Authorization Code Flow with PKCE by submitting the ITMO ID login form.
+// The login completes with tokens, or stops at a form the user has to fill in, see Submit.
+//
+// Deprecated: Get submits the user's ISU password to the ITMO ID login form itself,
+// use StartLogin and Exchange to let the user log in in their browser instead.
+func (c *Client) Get(ctx context.Context, isu int64, password string) (*entities.PasswordLogin, error) {
+	codeVerifier, err := generateCodeVerifier()
+	if err != nil {
+		return nil, errors.Wrap(err, "generate code verifier")
+	}
+
+	// Step 1: Get the login page
+	authURL := c.providerURL + "/protocol/openid-connect/auth"
+	params := url.Values{
+		"protocol":              {"oauth2"},
+		"response_type":         {"code"},
+		"client_id":             {c.clientID},
+		"redirect_uri":          {c.redirectURI},
+		"scope":                 {"openid"},
+		"state":                 {"im_not_a_browser"},
+		"code_challenge_method": {"S256"},
+		"code_challenge":        {getCodeChallenge(codeVerifier)},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, authURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "build auth request")
+	}
+
+	// Set request headers to mimic a browser
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/96.0.4664.110 Safari/537.36")
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,*/*;q=0.8")
+	req.Header.Set("Accept-Language", "en-US,en;q=0.5")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, unavailable(err, "auth request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, errors.Wrap(statusError(resp.StatusCode, body), "auth response")
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read auth response")
+	}
+
+	matches := _loginFormRe.FindStringSubmatch(string(body))
+	if len(matches) < 2 {
+		c.logger.Debug("form", zap.String("html", string(body)))
+		return nil, errors.New("login form not found")
+	}
+
+	// Step 2: Submit the login form
+	form := entities.LoginForm{
+		Action:  html.UnescapeString(matches[1]),
+		Fields:  extractHiddenFields(string(body)),
+		Cookies: map[string]string{},
+		Referer: resp.Request.URL.String(),
+	}
+	for _, cookie := range resp.Cookies() {
+		form.Cookies[cookie.Name] = cookie.Value
+	}
+
+	return c.submit(ctx, isu, codeVerifier, form, url.Values{
+		"username":   {strconv.FormatInt(isu, 10)},
+		"password":   {password},
+		"rememberMe": {"on"}, // Match Python implementation
+	})
+}
+
+// Submit answers the form a password login stopped at and continues the same Keycloak session.
+func (c *Client) Submit(ctx context.Context, login entities.PendingLogin, answer entities.ChallengeAnswer) (*entities.PasswordLogin, error) {
+	if login.Form == nil {
+		return nil, errors.New("login has no form to submit")
+	}
+
+	values := url.Values{}
+	switch login.Form.Kind {
+	case entities.ChallengeOTP:
+		values.Set("otp", answer.OTP)
+	case entities.ChallengeUpdatePassword:
+		values.Set("password-new", answer.NewPassword)
+		values.Set("password-confirm", answer.NewPassword)
+	case entities.ChallengeTerms:
+		values.Set("accept", "Accept")
+	default:
+		return nil, errors.Errorf("unknown challenge %q", login.Form.Kind)
+	}
+
+	return c.submit(ctx, login.ISU, login.CodeVerifier, *login.Form, values)
+}
+
+// submit posts the form with the values and follows the login to the next form or the code.
+func (c *Client) submit(ctx context.Context, isu int64, codeVerifier string, form entities.LoginForm, values url.Values) (*entities.PasswordLogin, error) {
+	data := url.Values{}
+	for k, v := range form.Fields {
+		data[k] = v
+	}
+	for k, v := range values {
+		data[k] = v
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, form.Action, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "build form request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Origin", c.providerURL)
+	req.Header.Set("Referer", form.Referer)
+
+	cookies := make(map[string]string, len(form.Cookies))
+	for k, v := range form.Cookies {
+		cookies[k] = v
+	}
+
+	p, err := c.do(req, cookies)
+	if err != nil {
+		return nil, errors.Wrap(err, "form submit")
+	}
+
+	// Step 3: Follow redirects until the code or the next page
+	for hop := 0; p.status == http.StatusFound || p.status == http.StatusSeeOther; hop++ {
+		u, err := url.Parse(p.location)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse redirect location")
+		}
+
+		if code := u.Query().Get("code"); code != "" {
+			// Step 4: Exchange the code for tokens
+			tokens, err := c.exchangeCode(ctx, code, codeVerifier, c.redirectURI)
+			if err != nil {
+				return nil, errors.Wrap(err, "exchange code")
+			}
+			tokens.ISU = isu
+
+			return &entities.PasswordLogin{ISU: isu, CodeVerifier: codeVerifier, Tokens: tokens}, nil
+		}
+		if reason := u.Query().Get("error"); reason != "" {
+			return nil, errors.Errorf("login failed: %s", reason)
+		}
+		if hop == _maxRedirects {
+			return nil, errors.New("too many redirects")
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.location, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "build redirect request")
+		}
+
+		p, err = c.do(req, cookies)
+		if err != nil {
+			return nil, errors.Wrap(err, "follow redirect")
+		}
+	}
+
+	if p.status != http.StatusOK {
+		return nil, errors.Wrap(statusError(p.status, []byte(p.body)), "form response")
+	}
+
+	next, err := c.parsePage(p)
+	if err != nil {
+		return nil, err
+	}
+	next.Cookies = cookies
+
+	return &entities.PasswordLogin{ISU: isu, CodeVerifier: codeVerifier, Form: next}, nil
+}
+
+// do sends the request with the cookies without following redirects,
+// cookies set by the response are added to them.
+func (c *Client) do(req *http.Request, cookies map[string]string) (*page, error) {
+	for name, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+
+	resp, err := c.loginClient.Do(req)
+	if err != nil {
+		return nil, unavailable(err, "login request")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response")
+	}
+
+	for _, cookie := range resp.Cookies() {
+		cookies[cookie.Name] = cookie.Value
+	}
+
+	p := &page{
+		status: resp.StatusCode,
+		body:   string(body),
+		url:    req.URL.String(),
+	}
+	if loc, err := resp.Location(); err == nil {
+		p.location = loc.String()
+	}
+
+	return p, nil
+}
+
+// parsePage recognises the Keycloak page the login stopped at.
+func (c *Client) parsePage(p *page) (*entities.LoginForm, error) {
+	var kind entities.ChallengeKind
+	switch {
+	case strings.Contains(p.body, `id="kc-otp-login-form"`):
+		kind = entities.ChallengeOTP
+	case strings.Contains(p.body, `id="kc-passwd-update-form"`):
+		kind = entities.ChallengeUpdatePassword
+	case strings.Contains(p.body, `id="kc-accept"`):
+		kind = entities.ChallengeTerms
+	case strings.Contains(p.body, `id="kc-form-login"`):
+		if message := extractFeedback(p.body); message != "" {
+			return nil, errors.WithMessage(ErrInvalidCredentials, message)
+		}
+		return nil, ErrInvalidCredentials
+	default:
+		c.logger.Debug("unexpected login page", zap.String("url", p.url), zap.String("html", p.body))
+		return nil, ErrUnexpectedPage
+	}
+
+	matches := _formRe.FindStringSubmatch(p.body)
+	if len(matches) < 2 {
+		return nil, errors.Errorf("form action of %s page not found", kind)
+	}
+
+	base, err := url.Parse(p.url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse page url")
+	}
+	action, err := base.Parse(html.UnescapeString(matches[1]))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse form action")
+	}
+
+	return &entities.LoginForm{
+		Kind:    kind,
+		Action:  action.String(),
+		Fields:  extractHiddenFields(p.body),
+		Referer: p.url,
+		Message: extractFeedback(p.body),
+	}, nil
+}
+
+// extractHiddenFields returns the hidden inputs of a page.
+func extractHiddenFields(body string) map[string][]string {
+	fields := make(map[string][]string)
+	for _, match := range _hiddenRe.FindAllStringSubmatch(body, -1) {
+		fields[match[1]] = []string{html.UnescapeString(match[2])}
+	}
+
+	return fields
+}
+
+// extractFeedback returns the error shown on a page, e.g. a wrong code or a weak password.
+func extractFeedback(body string) string {
+	matches := _feedbackRe.FindStringSubmatch(body)
+	if len(matches) < 2 {
+		return ""
+	}
+
+	return strings.TrimSpace(html.UnescapeString(matches[1]))
+}
@@ -0,0 +1,247 @@
+package caldavtargets
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/pkg/secretbox"
+)
+
+// _keyIDExpr extracts the key ID from an encrypted password.
+const _keyIDExpr = `CASE WHEN strpos(password, ':') > 0 THEN split_part(password, ':', 1) ELSE '' END`
+
+// Repository stores users' CalDAV targets with encrypted passwords and the objects pushed to them.
+type Repository struct {
+	db     *pgxpool.Pool
+	box    *secretbox.Keyring
+	logger *zap.Logger
+}
+
+// New creates a new Repository, passwords are encrypted with the keyring of stored tokens.
+func New(db *pgxpool.Pool, keyring *secretbox.Keyring, logger *zap.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		box:    keyring,
+		logger: logger.With(zap.String("component", "caldav_targets_repository")),
+	}
+}
+
+// Get returns the user's target, nil if there is none.
+func (r *Repository) Get(ctx context.Context, isu int64) (*entities.CalDavTarget, error) {
+	const query = `
+SELECT isu, url, username, password, created_at, updated_at
+FROM caldav_targets
+WHERE isu = $1`
+
+	var (
+		target   entities.CalDavTarget
+		password string
+	)
+	err := r.db.QueryRow(ctx, query, isu).Scan(&target.ISU, &target.URL, &target.Username, &password, &target.CreatedAt, &target.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "select caldav target")
+	}
+
+	target.Password, err = r.box.Decrypt(password)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt password")
+	}
+
+	return &target, nil
+}
+
+// Upsert stores the user's target. Objects pushed to a previous collection are forgotten.
+func (r *Repository) Upsert(ctx context.Context, target entities.CalDavTarget) error {
+	password, err := r.box.Encrypt(target.Password)
+	if err != nil {
+		return errors.Wrap(err, "encrypt password")
+	}
+
+	const query = `
+WITH previous AS (
+    SELECT url FROM caldav_targets WHERE isu = $1
+), upserted AS (
+    INSERT INTO caldav_targets (isu, url, username, password)
+    VALUES ($1, $2, $3, $4)
+    ON CONFLICT (isu) DO UPDATE SET
+        url = EXCLUDED.url,
+        username = EXCLUDED.username,
+        password = EXCLUDED.password,
+        updated_at = NOW()
+    RETURNING isu
+)
+DELETE FROM caldav_target_objects
+WHERE isu = $1 AND EXISTS (SELECT 1 FROM previous WHERE url <> $2)`
+
+	_, err = r.db.Exec(ctx, query, target.ISU, target.URL, target.Username, password)
+	if err != nil {
+		return errors.Wrap(err, "upsert caldav target")
+	}
+
+	return nil
+}
+
+// Delete removes the user's target and its objects. Returns false if there was none.
+func (r *Repository) Delete(ctx context.Context, isu int64) (bool, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM caldav_targets WHERE isu = $1`, isu)
+	if err != nil {
+		return false, errors.Wrap(err, "delete caldav target")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Objects returns the objects pushed to the user's target.
+func (r *Repository) Objects(ctx context.Context, isu int64) ([]entities.RemoteObject, error) {
+	const query = `
+SELECT isu, uid, href, etag, hash, deleted
+FROM caldav_target_objects
+WHERE isu = $1`
+
+	rows, err := r.db.Query(ctx, query, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "select caldav target objects")
+	}
+	defer rows.Close()
+
+	var objects []entities.RemoteObject
+	for rows.Next() {
+		var o entities.RemoteObject
+		err = rows.Scan(&o.ISU, &o.UID, &o.Href, &o.ETag, &o.Hash, &o.Deleted)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan caldav target object")
+		}
+		objects = append(objects, o)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows error")
+	}
+
+	return objects, nil
+}
+
+// SaveObject records an object written to the target or deleted on it.
+func (r *Repository) SaveObject(ctx context.Context, object entities.RemoteObject) error {
+	const query = `
+INSERT INTO caldav_target_objects (isu, uid, href, etag, hash, deleted)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (isu, uid) DO UPDATE SET
+    href = EXCLUDED.href,
+    etag = EXCLUDED.etag,
+    hash = EXCLUDED.hash,
+    deleted = EXCLUDED.deleted`
+
+	_, err := r.db.Exec(ctx, query, object.ISU, object.UID, object.Href, object.ETag, object.Hash, object.Deleted)
+	if err != nil {
+		return errors.Wrap(err, "upsert caldav target object")
+	}
+
+	return nil
+}
+
+// DeleteObject forgets an object of the target.
+func (r *Repository) DeleteObject(ctx context.Context, isu int64, uid string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM caldav_target_objects WHERE isu = $1 AND uid = $2`, isu, uid)
+	if err != nil {
+		return errors.Wrap(err, "delete caldav target object")
+	}
+
+	return nil
+}
+
+// KeyUsage returns how many passwords are encrypted with each key.
+func (r *Repository) KeyUsage(ctx context.Context) (map[string]int64, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+_keyIDExpr+`, COUNT(*) FROM caldav_targets GROUP BY 1`)
+	if err != nil {
+		return nil, errors.Wrap(err, "count caldav targets by key")
+	}
+	defer rows.Close()
+
+	usage := make(map[string]int64)
+	for rows.Next() {
+		var (
+			keyID string
+			count int64
+		)
+		err = rows.Scan(&keyID, &count)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan key usage")
+		}
+		usage[keyID] = count
+	}
+
+	return usage, errors.Wrap(rows.Err(), "iterate key usage")
+}
+
+// Reencrypt re-encrypts up to limit passwords after the ISU that do not use the current key.
+// Rows changed concurrently are left for the next run, rows of unknown keys are skipped.
+func (r *Repository) Reencrypt(ctx context.Context, after int64, limit int) (entities.ReencryptPage, error) {
+	const query = `
+SELECT isu, password
+FROM caldav_targets
+WHERE ` + _keyIDExpr + ` <> $1 AND isu > $2
+ORDER BY isu
+LIMIT $3`
+
+	var page entities.ReencryptPage
+
+	rows, err := r.db.Query(ctx, query, r.box.Current(), after, limit)
+	if err != nil {
+		return page, errors.Wrap(err, "select stale caldav targets")
+	}
+
+	type stalePassword struct {
+		isu      int64
+		password string
+	}
+	var stale []stalePassword
+	for rows.Next() {
+		var row stalePassword
+		err = rows.Scan(&row.isu, &row.password)
+		if err != nil {
+			rows.Close()
+			return page, errors.Wrap(err, "scan stale caldav targets")
+		}
+		stale = append(stale, row)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return page, errors.Wrap(err, "iterate stale caldav targets")
+	}
+
+	const update = `UPDATE caldav_targets SET password = $3 WHERE isu = $1 AND password = $2`
+
+	for _, row := range stale {
+		page.Last = row.isu
+		page.Read++
+
+		plaintext, err := r.box.Decrypt(row.password)
+		if err != nil {
+			r.logger.Warn("failed to re-encrypt password", zap.Error(err), zap.Int64("isu", row.isu))
+			page.Skipped++
+			continue
+		}
+		password, err := r.box.Encrypt(plaintext)
+		if err != nil {
+			return page, errors.Wrap(err, "encrypt password")
+		}
+
+		tag, err := r.db.Exec(ctx, update, row.isu, row.password, password)
+		if err != nil {
+			return page, errors.Wrap(err, "update caldav target")
+		}
+		page.Updated += int(tag.RowsAffected())
+	}
+
+	return page, nil
+}
@@ -2,11 +2,14 @@ package caldav
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"strings"
 
 	"github.com/hexarchy/itmo-calendar/internal/entities"
 
 	ics "github.com/arran4/golang-ical"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 )
@@ -19,30 +22,44 @@ func New(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
 
-// Create inserts or updates a user's iCal data.
+// Create inserts or updates a user's iCal data. The modification time only moves when the content changes.
+// Nothing is stored for users that do not exist, so work queued before an account deletion can not bring data back.
+// The user row is share-locked, so a deletion in progress is waited for instead of being raced.
 func (r *Repository) Create(ctx context.Context, caldav entities.CalDav) error {
 	const query = `
-INSERT INTO caldav (isu, ical)
-VALUES ($1, $2)
-ON CONFLICT (isu) DO UPDATE SET ical = EXCLUDED.ical
+INSERT INTO caldav (isu, ical, etag, updated_at)
+SELECT $1, $2, $3, NOW()
+WHERE EXISTS (SELECT 1 FROM users WHERE isu = $1 FOR KEY SHARE)
+ON CONFLICT (isu) DO UPDATE SET
+    ical = EXCLUDED.ical,
+    etag = EXCLUDED.etag,
+    updated_at = CASE WHEN caldav.etag = EXCLUDED.etag THEN caldav.updated_at ELSE EXCLUDED.updated_at END
 `
 
-	_, err := r.db.Exec(ctx, query, caldav.ISU, []byte(caldav.ICal.Serialize()))
+	data := []byte(caldav.ICal.Serialize())
+	_, err := r.db.Exec(ctx, query, caldav.ISU, data, etag(data))
 	if err != nil {
 		return errors.Wrap(err, "caldav repository: create")
 	}
 	return nil
 }
 
-// Get retrieves a user's iCal data by ISU.
+// Get retrieves a user's iCal data by ISU. ICal is nil when the user has no calendar yet.
 func (r *Repository) Get(ctx context.Context, isu int64) (entities.CalDav, error) {
-	const query = `SELECT isu, ical FROM caldav WHERE isu = $1`
+	const query = `SELECT isu, ical, etag, updated_at FROM caldav WHERE isu = $1`
 	var caldav entities.CalDav
 	var ical []byte
-	err := r.db.QueryRow(ctx, query, isu).Scan(&caldav.ISU, &ical)
+	err := r.db.QueryRow(ctx, query, isu).Scan(&caldav.ISU, &ical, &caldav.ETag, &caldav.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entities.CalDav{ISU: isu}, nil
+	}
 	if err != nil {
 		return entities.CalDav{}, errors.Wrap(err, "caldav repository: get")
 	}
+	// Rows stored before validators were kept have no ETag yet.
+	if caldav.ETag == "" {
+		caldav.ETag = etag(ical)
+	}
 
 	caldav.ICal, err = ics.ParseCalendar(strings.NewReader(string(ical)))
 	if err != nil {
@@ -51,3 +68,9 @@ func (r *Repository) Get(ctx context.Context, isu int64) (entities.CalDav, error
 
 	return caldav, nil
 }
+
+// etag returns a strong entity tag for the serialized calendar.
+func etag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
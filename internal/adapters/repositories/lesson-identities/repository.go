@@ -0,0 +1,80 @@
+package lessonidentities
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetByISU returns all lesson identities of a user.
+func (r *Repository) GetByISU(ctx context.Context, isu int64) ([]entities.LessonIdentity, error) {
+	const query = `
+SELECT isu, slot, uid, sequence, fingerprint, created_at, modified_at, cancelled_at
+FROM lesson_identities
+WHERE isu = $1`
+
+	rows, err := r.db.Query(ctx, query, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "select lesson identities")
+	}
+	defer rows.Close()
+
+	var identities []entities.LessonIdentity
+	for rows.Next() {
+		var i entities.LessonIdentity
+		err = rows.Scan(&i.ISU, &i.Slot, &i.UID, &i.Sequence, &i.Fingerprint, &i.CreatedAt, &i.ModifiedAt, &i.CancelledAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan lesson identity")
+		}
+		identities = append(identities, i)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows error")
+	}
+
+	return identities, nil
+}
+
+// Upsert inserts or updates lesson identities. Nothing is stored for users that do not exist,
+// see the caldav repository's Create.
+func (r *Repository) Upsert(ctx context.Context, identities []entities.LessonIdentity) error {
+	if len(identities) == 0 {
+		return nil
+	}
+
+	const query = `
+INSERT INTO lesson_identities (isu, slot, uid, sequence, fingerprint, created_at, modified_at, cancelled_at)
+SELECT $1, $2, $3, $4, $5, $6, $7, $8
+WHERE EXISTS (SELECT 1 FROM users WHERE isu = $1 FOR KEY SHARE)
+ON CONFLICT (isu, slot) DO UPDATE SET
+    sequence = EXCLUDED.sequence,
+    fingerprint = EXCLUDED.fingerprint,
+    modified_at = EXCLUDED.modified_at,
+    cancelled_at = EXCLUDED.cancelled_at`
+
+	batch := &pgx.Batch{}
+	for _, i := range identities {
+		batch.Queue(query, i.ISU, i.Slot, i.UID, i.Sequence, i.Fingerprint, i.CreatedAt, i.ModifiedAt, i.CancelledAt)
+	}
+
+	err := r.db.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return errors.Wrap(err, "upsert lesson identities")
+	}
+
+	return nil
+}
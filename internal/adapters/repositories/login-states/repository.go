@@ -0,0 +1,114 @@
+package loginstates
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/pkg/secretbox"
+)
+
+// Repository stores logins between their start and completion. Forms of password logins
+// carry Keycloak session cookies and are stored encrypted.
+type Repository struct {
+	db  *pgxpool.Pool
+	box *secretbox.Keyring
+}
+
+func New(db *pgxpool.Pool, keyring *secretbox.Keyring) *Repository {
+	return &Repository{
+		db:  db,
+		box: keyring,
+	}
+}
+
+// Create stores a started login.
+func (r *Repository) Create(ctx context.Context, login entities.PendingLogin) error {
+	const query = `
+INSERT INTO login_states (state, code_verifier, isu, form, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)`
+
+	var isu *int64
+	if login.ISU != 0 {
+		isu = &login.ISU
+	}
+
+	var form *string
+	if login.Form != nil {
+		raw, err := json.Marshal(login.Form)
+		if err != nil {
+			return errors.Wrap(err, "marshal form")
+		}
+
+		encrypted, err := r.box.Encrypt(string(raw))
+		if err != nil {
+			return errors.Wrap(err, "encrypt form")
+		}
+		form = &encrypted
+	}
+
+	_, err := r.db.Exec(ctx, query, login.State, login.CodeVerifier, isu, form, login.CreatedAt, login.ExpiresAt)
+	if err != nil {
+		return errors.Wrap(err, "insert login state")
+	}
+
+	return nil
+}
+
+// Take removes and returns the login of the state, nil if there is none.
+// A state can only be taken once, so a callback or an answer can not be replayed.
+func (r *Repository) Take(ctx context.Context, state string) (*entities.PendingLogin, error) {
+	const query = `
+DELETE FROM login_states
+WHERE state = $1
+RETURNING state, code_verifier, isu, form, created_at, expires_at`
+
+	var (
+		login entities.PendingLogin
+		isu   *int64
+		form  *string
+	)
+	err := r.db.QueryRow(ctx, query, state).Scan(
+		&login.State, &login.CodeVerifier, &isu, &form, &login.CreatedAt, &login.ExpiresAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "delete login state")
+	}
+
+	if isu != nil {
+		login.ISU = *isu
+	}
+
+	if form != nil {
+		raw, err := r.box.Decrypt(*form)
+		if err != nil {
+			return nil, errors.Wrap(err, "decrypt form")
+		}
+
+		login.Form = &entities.LoginForm{}
+		err = json.Unmarshal([]byte(raw), login.Form)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshal form")
+		}
+	}
+
+	return &login, nil
+}
+
+// DeleteExpired removes logins that were never completed.
+func (r *Repository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM login_states WHERE expires_at < $1`, now)
+	if err != nil {
+		return 0, errors.Wrap(err, "delete expired login states")
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,88 @@
+package reminders
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Get returns the user's reminder rules in priority order.
+func (r *Repository) Get(ctx context.Context, isu int64) ([]entities.ReminderRule, error) {
+	const query = `
+SELECT lesson_type, format, minutes_before
+FROM user_reminders
+WHERE isu = $1
+ORDER BY position`
+
+	rows, err := r.db.Query(ctx, query, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "select reminders")
+	}
+	defer rows.Close()
+
+	var rules []entities.ReminderRule
+	for rows.Next() {
+		var rule entities.ReminderRule
+		err = rows.Scan(&rule.LessonType, &rule.Format, &rule.MinutesBefore)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan reminder")
+		}
+		rules = append(rules, rule)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows error")
+	}
+
+	return rules, nil
+}
+
+// Replace atomically replaces all reminder rules of the user.
+func (r *Repository) Replace(ctx context.Context, isu int64, rules []entities.ReminderRule) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "begin tx")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	_, err = tx.Exec(ctx, `DELETE FROM user_reminders WHERE isu = $1`, isu)
+	if err != nil {
+		return errors.Wrap(err, "delete reminders")
+	}
+
+	const query = `
+INSERT INTO user_reminders (isu, position, lesson_type, format, minutes_before)
+VALUES ($1, $2, $3, $4, $5)`
+
+	batch := &pgx.Batch{}
+	for i, rule := range rules {
+		batch.Queue(query, isu, i, rule.LessonType, rule.Format, rule.MinutesBefore)
+	}
+
+	err = tx.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return errors.Wrap(err, "insert reminders")
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit tx")
+	}
+
+	return nil
+}
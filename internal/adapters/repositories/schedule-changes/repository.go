@@ -0,0 +1,136 @@
+package schedulechanges
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Add appends changes to the log. Nothing is stored for users that do not exist,
+// see the caldav repository's Create.
+func (r *Repository) Add(ctx context.Context, changes []entities.ScheduleChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	const query = `
+INSERT INTO schedule_changes (isu, uid, change_type, date, lesson, changed_at)
+SELECT $1, $2, $3, $4, $5, $6
+WHERE EXISTS (SELECT 1 FROM users WHERE isu = $1 FOR KEY SHARE)`
+
+	batch := &pgx.Batch{}
+	for _, c := range changes {
+		var lesson []byte
+		if c.Lesson != nil {
+			var err error
+			lesson, err = json.Marshal(c.Lesson)
+			if err != nil {
+				return errors.Wrap(err, "marshal lesson")
+			}
+		}
+		batch.Queue(query, c.ISU, c.UID, string(c.Type), c.Date, lesson, c.ChangedAt)
+	}
+
+	err := r.db.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return errors.Wrap(err, "insert schedule changes")
+	}
+
+	return nil
+}
+
+// Since returns the user's changes with ids above after in log order.
+func (r *Repository) Since(ctx context.Context, isu, after int64) ([]entities.ScheduleChange, error) {
+	const query = `
+SELECT id, isu, uid, change_type, date, lesson, changed_at
+FROM schedule_changes
+WHERE isu = $1 AND id > $2
+ORDER BY id`
+
+	rows, err := r.db.Query(ctx, query, isu, after)
+	if err != nil {
+		return nil, errors.Wrap(err, "select schedule changes")
+	}
+	defer rows.Close()
+
+	var changes []entities.ScheduleChange
+	for rows.Next() {
+		var (
+			c          entities.ScheduleChange
+			changeType string
+			lesson     []byte
+		)
+		err = rows.Scan(&c.ID, &c.ISU, &c.UID, &changeType, &c.Date, &lesson, &c.ChangedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan schedule change")
+		}
+		c.Type = entities.ChangeType(changeType)
+
+		if lesson != nil {
+			c.Lesson = &entities.Lesson{}
+			err = json.Unmarshal(lesson, c.Lesson)
+			if err != nil {
+				return nil, errors.Wrap(err, "unmarshal lesson")
+			}
+		}
+
+		changes = append(changes, c)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows error")
+	}
+
+	return changes, nil
+}
+
+// Bounds returns the id of the user's latest change and the id the log was pruned through.
+func (r *Repository) Bounds(ctx context.Context, isu int64) (latest, prunedThrough int64, err error) {
+	const query = `
+SELECT
+    COALESCE((SELECT MAX(id) FROM schedule_changes WHERE isu = $1), 0),
+    COALESCE((SELECT changes_pruned_through FROM caldav WHERE isu = $1), 0)`
+
+	err = r.db.QueryRow(ctx, query, isu).Scan(&latest, &prunedThrough)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "select schedule change bounds")
+	}
+
+	return latest, prunedThrough, nil
+}
+
+// Prune deletes the user's changes made before the given time and remembers
+// the latest deleted id, so tokens pointing before it are known to be expired.
+func (r *Repository) Prune(ctx context.Context, isu int64, before time.Time) error {
+	const query = `
+WITH pruned AS (
+    DELETE FROM schedule_changes
+    WHERE isu = $1 AND changed_at < $2
+    RETURNING id
+)
+UPDATE caldav
+SET changes_pruned_through = GREATEST(changes_pruned_through, (SELECT MAX(id) FROM pruned))
+WHERE isu = $1 AND EXISTS (SELECT 1 FROM pruned)`
+
+	_, err := r.db.Exec(ctx, query, isu, before)
+	if err != nil {
+		return errors.Wrap(err, "prune schedule changes")
+	}
+
+	return nil
+}
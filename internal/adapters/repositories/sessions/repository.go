@@ -0,0 +1,95 @@
+package sessions
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// Repository stores API sessions of users.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Create stores a new session.
+func (r *Repository) Create(ctx context.Context, session entities.Session) error {
+	const query = `
+INSERT INTO sessions (id, isu, refresh_id, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.db.Exec(ctx, query, session.ID, session.ISU, session.RefreshID, session.CreatedAt, session.ExpiresAt)
+	if err != nil {
+		return errors.Wrap(err, "insert session")
+	}
+
+	return nil
+}
+
+// Get returns the session, nil if there is none.
+func (r *Repository) Get(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+	const query = `
+SELECT id, isu, refresh_id, created_at, expires_at, revoked_at
+FROM sessions
+WHERE id = $1`
+
+	var session entities.Session
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&session.ID, &session.ISU, &session.RefreshID, &session.CreatedAt, &session.ExpiresAt, &session.RevokedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "select session")
+	}
+
+	return &session, nil
+}
+
+// RotateRefresh replaces the refresh token of an active session if it is still the given one.
+// Returns false if the session is revoked or the token was already rotated.
+func (r *Repository) RotateRefresh(ctx context.Context, id, current, next uuid.UUID, expiresAt time.Time) (bool, error) {
+	const query = `
+UPDATE sessions
+SET refresh_id = $3, expires_at = $4
+WHERE id = $1 AND refresh_id = $2 AND revoked_at IS NULL`
+
+	tag, err := r.db.Exec(ctx, query, id, current, next, expiresAt)
+	if err != nil {
+		return false, errors.Wrap(err, "update session")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Revoke revokes the session. Returns false if it is unknown or already revoked.
+func (r *Repository) Revoke(ctx context.Context, id uuid.UUID) (bool, error) {
+	tag, err := r.db.Exec(ctx, `UPDATE sessions SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return false, errors.Wrap(err, "revoke session")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// RevokeAll revokes all sessions of the user and returns how many there were.
+func (r *Repository) RevokeAll(ctx context.Context, isu int64) (int64, error) {
+	tag, err := r.db.Exec(ctx, `UPDATE sessions SET revoked_at = NOW() WHERE isu = $1 AND revoked_at IS NULL`, isu)
+	if err != nil {
+		return 0, errors.Wrap(err, "revoke sessions")
+	}
+
+	return tag.RowsAffected(), nil
+}
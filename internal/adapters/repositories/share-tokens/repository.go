@@ -0,0 +1,78 @@
+package sharetokens
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// Repository stores hashes of share tokens, one per user and scope.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Replace stores the token hash of the scope, invalidating the previous token.
+func (r *Repository) Replace(ctx context.Context, isu int64, scope entities.ShareScope, hash string) error {
+	const query = `
+INSERT INTO share_tokens (isu, scope, token_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT (isu, scope) DO UPDATE SET
+    token_hash = EXCLUDED.token_hash,
+    created_at = NOW()`
+
+	_, err := r.db.Exec(ctx, query, isu, string(scope), hash)
+	if err != nil {
+		return errors.Wrap(err, "upsert share token")
+	}
+
+	return nil
+}
+
+// Insert stores the token hash of the scope unless the user already has one. Returns false if they had.
+func (r *Repository) Insert(ctx context.Context, isu int64, scope entities.ShareScope, hash string) (bool, error) {
+	const query = `
+INSERT INTO share_tokens (isu, scope, token_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT (isu, scope) DO NOTHING`
+
+	tag, err := r.db.Exec(ctx, query, isu, string(scope), hash)
+	if err != nil {
+		return false, errors.Wrap(err, "insert share token")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Delete removes the token of the scope. Returns false if there was none.
+func (r *Repository) Delete(ctx context.Context, isu int64, scope entities.ShareScope) (bool, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM share_tokens WHERE isu = $1 AND scope = $2`, isu, string(scope))
+	if err != nil {
+		return false, errors.Wrap(err, "delete share token")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// FindISU returns the owner of the token hash in the scope, nil if there is none.
+func (r *Repository) FindISU(ctx context.Context, scope entities.ShareScope, hash string) (*int64, error) {
+	var isu int64
+	err := r.db.QueryRow(ctx, `SELECT isu FROM share_tokens WHERE scope = $1 AND token_hash = $2`, string(scope), hash).Scan(&isu)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "select share token")
+	}
+
+	return &isu, nil
+}
@@ -2,12 +2,6 @@ package usertokens
 
 import (
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
-	"crypto/sha256"
-	"encoding/hex"
-	"io"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -16,22 +10,27 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/pkg/secretbox"
 )
 
+// _keyIDExpr extracts the key ID from an encrypted refresh token, both tokens of a row share the key.
+const _keyIDExpr = `CASE WHEN strpos(refresh_token, ':') > 0 THEN split_part(refresh_token, ':', 1) ELSE '' END`
+
+// _refreshLockSpace namespaces the advisory locks taken by WithRefreshLock.
+const _refreshLockSpace = `hashtext('user_tokens_refresh')`
+
 // Repository provides access to user tokens storage.
 type Repository struct {
 	db     *pgxpool.Pool
-	secret []byte
+	box    *secretbox.Keyring
 	logger *zap.Logger
 }
 
 // New creates a new Repository instance.
-func New(db *pgxpool.Pool, secret string, logger *zap.Logger) *Repository {
-	key := sha256.Sum256([]byte(secret))
-
+func New(db *pgxpool.Pool, keyring *secretbox.Keyring, logger *zap.Logger) *Repository {
 	return &Repository{
 		db:     db,
-		secret: key[:],
+		box:    keyring,
 		logger: logger.With(zap.String("component", "user_tokens_repository")),
 	}
 }
@@ -69,12 +68,12 @@ LIMIT 1`
 		return nil, errors.Wrap(err, "scan user tokens")
 	}
 
-	accessToken, err := r.decrypt(encAccessToken)
+	accessToken, err := r.box.Decrypt(encAccessToken)
 	if err != nil {
 		return nil, errors.Wrap(err, "decrypt access token")
 	}
 
-	refreshToken, err := r.decrypt(encRefreshToken)
+	refreshToken, err := r.box.Decrypt(encRefreshToken)
 	if err != nil {
 		return nil, errors.Wrap(err, "decrypt refresh token")
 	}
@@ -90,12 +89,12 @@ func (r *Repository) UpsertUserTokens(ctx context.Context, tokens *entities.User
 	now := time.Now().UTC()
 	tokens.UpdatedAt = now
 
-	encAccessToken, err := r.encrypt(tokens.AccessToken)
+	encAccessToken, err := r.box.Encrypt(tokens.AccessToken)
 	if err != nil {
 		return errors.Wrap(err, "encrypt access token")
 	}
 
-	encRefreshToken, err := r.encrypt(tokens.RefreshToken)
+	encRefreshToken, err := r.box.Encrypt(tokens.RefreshToken)
 	if err != nil {
 		return errors.Wrap(err, "encrypt refresh token")
 	}
@@ -144,55 +143,202 @@ ON CONFLICT (isu) DO UPDATE SET
 	return nil
 }
 
-// encrypt encrypts a plaintext string using AES-GCM.
-func (r *Repository) encrypt(plaintext string) (string, error) {
-	block, err := aes.NewCipher(r.secret)
+// UpdateUserTokens replaces the user's stored tokens. Returns false if there are none,
+// so tokens refreshed while the account was being deleted are not stored again.
+func (r *Repository) UpdateUserTokens(ctx context.Context, tokens *entities.UserTokens) (bool, error) {
+	tokens.UpdatedAt = time.Now().UTC()
+
+	encAccessToken, err := r.box.Encrypt(tokens.AccessToken)
+	if err != nil {
+		return false, errors.Wrap(err, "encrypt access token")
+	}
+
+	encRefreshToken, err := r.box.Encrypt(tokens.RefreshToken)
+	if err != nil {
+		return false, errors.Wrap(err, "encrypt refresh token")
+	}
+
+	const query = `
+UPDATE user_tokens
+SET access_token = $2,
+    refresh_token = $3,
+    access_token_expires_at = $4,
+    refresh_token_expires_at = $5,
+    updated_at = $6
+WHERE isu = $1`
+
+	tag, err := r.db.Exec(
+		ctx,
+		query,
+		tokens.ISU,
+		encAccessToken,
+		encRefreshToken,
+		tokens.AccessTokenExpiresAt,
+		tokens.RefreshTokenExpiresAt,
+		tokens.UpdatedAt,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "update user tokens")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// WithRefreshLock runs fn while holding a transaction-scoped advisory lock on the user's tokens,
+// so only one instance refreshes them at a time. fn must use the repository methods, not the lock transaction.
+func (r *Repository) WithRefreshLock(ctx context.Context, isu int64, fn func(ctx context.Context) error) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return "", errors.Wrap(err, "new cipher")
+		return errors.Wrap(err, "begin tx")
 	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
 
-	aesGCM, err := cipher.NewGCM(block)
+	_, err = tx.Exec(ctx, `SELECT pg_advisory_xact_lock(`+_refreshLockSpace+`, $1::int4)`, isu)
 	if err != nil {
-		return "", errors.Wrap(err, "new gcm")
+		return errors.Wrap(err, "acquire refresh lock")
 	}
 
-	nonce := make([]byte, aesGCM.NonceSize())
-	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
-		return "", errors.Wrap(err, "generate nonce")
+	err = fn(ctx)
+	if err != nil {
+		return err
 	}
 
-	ciphertext := aesGCM.Seal(nonce, nonce, []byte(plaintext), nil)
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit tx")
+	}
 
-	return hex.EncodeToString(ciphertext), nil
+	return nil
 }
 
-// decrypt decrypts a ciphertext string using AES-GCM.
-func (r *Repository) decrypt(encrypted string) (string, error) {
-	ciphertext, err := hex.DecodeString(encrypted)
+// FindExpiring returns up to limit ISUs of active users whose refresh token expires before the time, soonest first.
+func (r *Repository) FindExpiring(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	const query = `
+SELECT t.isu
+FROM user_tokens t
+JOIN users u ON u.isu = t.isu
+WHERE u.status = 'active' AND t.refresh_token_expires_at < $1
+ORDER BY t.refresh_token_expires_at
+LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, before, limit)
 	if err != nil {
-		return "", errors.Wrap(err, "hex decode")
+		return nil, errors.Wrap(err, "select expiring user tokens")
+	}
+	defer rows.Close()
+
+	var isus []int64
+	for rows.Next() {
+		var isu int64
+		err = rows.Scan(&isu)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan expiring user tokens")
+		}
+		isus = append(isus, isu)
 	}
 
-	block, err := aes.NewCipher(r.secret)
+	return isus, errors.Wrap(rows.Err(), "iterate expiring user tokens")
+}
+
+// KeyUsage returns how many rows are encrypted with each key.
+func (r *Repository) KeyUsage(ctx context.Context) (map[string]int64, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+_keyIDExpr+`, COUNT(*) FROM user_tokens GROUP BY 1`)
 	if err != nil {
-		return "", errors.Wrap(err, "new cipher")
+		return nil, errors.Wrap(err, "count user tokens by key")
+	}
+	defer rows.Close()
+
+	usage := make(map[string]int64)
+	for rows.Next() {
+		var (
+			keyID string
+			count int64
+		)
+		err = rows.Scan(&keyID, &count)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan key usage")
+		}
+		usage[keyID] = count
 	}
 
-	aesGCM, err := cipher.NewGCM(block)
+	return usage, errors.Wrap(rows.Err(), "iterate key usage")
+}
+
+// Reencrypt re-encrypts up to limit rows after the ISU that do not use the current key.
+// Rows changed concurrently are left for the next run, rows of unknown keys are skipped.
+func (r *Repository) Reencrypt(ctx context.Context, after int64, limit int) (entities.ReencryptPage, error) {
+	const query = `
+SELECT isu, access_token, refresh_token
+FROM user_tokens
+WHERE ` + _keyIDExpr + ` <> $1 AND isu > $2
+ORDER BY isu
+LIMIT $3`
+
+	var page entities.ReencryptPage
+
+	rows, err := r.db.Query(ctx, query, r.box.Current(), after, limit)
 	if err != nil {
-		return "", errors.Wrap(err, "new gcm")
+		return page, errors.Wrap(err, "select stale user tokens")
+	}
+
+	type staleTokens struct {
+		isu             int64
+		access, refresh string
 	}
+	var stale []staleTokens
+	for rows.Next() {
+		var row staleTokens
+		err = rows.Scan(&row.isu, &row.access, &row.refresh)
+		if err != nil {
+			rows.Close()
+			return page, errors.Wrap(err, "scan stale user tokens")
+		}
+		stale = append(stale, row)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return page, errors.Wrap(err, "iterate stale user tokens")
+	}
+
+	const update = `
+UPDATE user_tokens
+SET access_token = $3, refresh_token = $4
+WHERE isu = $1 AND refresh_token = $2`
 
-	nonceSize := aesGCM.NonceSize()
-	if len(ciphertext) < nonceSize {
-		return "", errors.New("ciphertext too short")
+	for _, row := range stale {
+		page.Last = row.isu
+		page.Read++
+
+		access, err := r.reencrypt(row.access)
+		if err != nil {
+			r.logger.Warn("failed to re-encrypt access token", zap.Error(err), zap.Int64("isu", row.isu))
+			page.Skipped++
+			continue
+		}
+		refresh, err := r.reencrypt(row.refresh)
+		if err != nil {
+			r.logger.Warn("failed to re-encrypt refresh token", zap.Error(err), zap.Int64("isu", row.isu))
+			page.Skipped++
+			continue
+		}
+
+		tag, err := r.db.Exec(ctx, update, row.isu, row.refresh, access, refresh)
+		if err != nil {
+			return page, errors.Wrap(err, "update user tokens")
+		}
+		page.Updated += int(tag.RowsAffected())
 	}
 
-	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
-	plaintext, err := aesGCM.Open(nil, nonce, ciphertext, nil)
+	return page, nil
+}
+
+func (r *Repository) reencrypt(encrypted string) (string, error) {
+	plaintext, err := r.box.Decrypt(encrypted)
 	if err != nil {
 		return "", errors.Wrap(err, "decrypt")
 	}
 
-	return string(plaintext), nil
+	return r.box.Encrypt(plaintext)
 }
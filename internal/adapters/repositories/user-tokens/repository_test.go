@@ -0,0 +1,113 @@
+package usertokens
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/pkg/secretbox"
+)
+
+// testDB returns a pool working in a throwaway schema of the database in ITMO_CALENDAR_TEST_POSTGRES.
+func testDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("ITMO_CALENDAR_TEST_POSTGRES")
+	if dsn == "" {
+		t.Skip("ITMO_CALENDAR_TEST_POSTGRES is not set")
+	}
+
+	ctx := context.Background()
+	cfg, err := pgxpool.ParseConfig(dsn)
+	require.NoError(t, err)
+	schema := fmt.Sprintf("user_tokens_test_%d", time.Now().UnixNano())
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+
+	db, err := pgxpool.NewWithConfig(ctx, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE")
+		db.Close()
+	})
+
+	_, err = db.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `
+CREATE TABLE user_tokens (
+    isu BIGINT PRIMARY KEY,
+    access_token TEXT NOT NULL,
+    refresh_token TEXT NOT NULL,
+    access_token_expires_at TIMESTAMPTZ NOT NULL,
+    refresh_token_expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+)`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func testKeyring(t *testing.T, current string, secrets map[string]string) *secretbox.Keyring {
+	t.Helper()
+
+	keyring, err := secretbox.NewKeyring(current, secrets)
+	require.NoError(t, err)
+
+	return keyring
+}
+
+func TestReencrypt(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+
+	store := func(keyring *secretbox.Keyring, isu int64) {
+		err := New(db, keyring, zap.NewNop()).UpsertUserTokens(ctx, &entities.UserTokens{
+			ISU:                   isu,
+			AccessToken:           fmt.Sprintf("access-%d", isu),
+			RefreshToken:          fmt.Sprintf("refresh-%d", isu),
+			AccessTokenExpiresAt:  time.Now().Add(time.Hour),
+			RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+		})
+		require.NoError(t, err)
+	}
+
+	// The first rows use a key that is no longer configured, they must not hold back the rows after them.
+	removed := testKeyring(t, "gone", map[string]string{"gone": "removed secret"})
+	old := testKeyring(t, "k1", map[string]string{"k1": "old secret"})
+	for isu := int64(1); isu <= 3; isu++ {
+		store(removed, isu)
+	}
+	for isu := int64(4); isu <= 6; isu++ {
+		store(old, isu)
+	}
+
+	repo := New(db, testKeyring(t, "k2", map[string]string{"k1": "old secret", "k2": "new secret"}), zap.NewNop())
+
+	page, err := repo.Reencrypt(ctx, 0, 3)
+	require.NoError(t, err)
+	assert.Equal(t, entities.ReencryptPage{Last: 3, Read: 3, Skipped: 3}, page)
+
+	page, err = repo.Reencrypt(ctx, page.Last, 3)
+	require.NoError(t, err)
+	assert.Equal(t, entities.ReencryptPage{Last: 6, Read: 3, Updated: 3}, page)
+
+	page, err = repo.Reencrypt(ctx, page.Last, 3)
+	require.NoError(t, err)
+	assert.Zero(t, page.Read)
+
+	usage, err := repo.KeyUsage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"gone": 3, "k2": 3}, usage)
+
+	tokens, err := repo.Get(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-5", tokens.RefreshToken)
+}
@@ -8,10 +8,14 @@ import (
 
 	"github.com/hexarchy/itmo-calendar/internal/entities"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 )
 
+const _userColumns = `isu, time_zone, locale, compress_recurrence, week_markers, summary_template, description_template, location_template,
+    status, status_changed_at, last_synced_at, last_failed_at, created_at, updated_at`
+
 type Repository struct {
 	db *pgxpool.Pool
 }
@@ -22,28 +26,29 @@ func New(db *pgxpool.Pool) *Repository {
 	}
 }
 
+// Create creates the user, or makes an existing one active again on a new subscription.
 func (r *Repository) Create(ctx context.Context, isu int64) (*entities.User, error) {
-	user := &entities.User{
-		ISU:       isu,
-		UpdatedAt: time.Now(),
-		CreatedAt: time.Now(),
-	}
-
 	const query = `
 INSERT INTO users (isu, created_at, updated_at)
-VALUES ($1, $2, $3)
-	`
-	_, err := r.db.Exec(ctx, query, user.ISU, user.CreatedAt, user.UpdatedAt)
+VALUES ($1, $2, $2)
+ON CONFLICT (isu) DO UPDATE SET
+    status = 'active',
+    status_changed_at = CASE WHEN users.status <> 'active' THEN EXCLUDED.updated_at ELSE users.status_changed_at END,
+    updated_at = EXCLUDED.updated_at
+RETURNING ` + _userColumns
+
+	var u entities.User
+	err := scanUser(r.db.QueryRow(ctx, query, isu, time.Now()), &u)
 	if err != nil {
-		return nil, errors.Wrap(err, "insert user")
+		return nil, errors.Wrap(err, "upsert user")
 	}
 
-	return user, nil
+	return &u, nil
 }
 
 func (r *Repository) GetAll(ctx context.Context) ([]entities.User, error) {
 	const query = `
-SELECT isu, created_at, updated_at
+SELECT ` + _userColumns + `
 FROM users
 	`
 	rows, err := r.db.Query(ctx, query)
@@ -56,7 +61,7 @@ FROM users
 
 	for rows.Next() {
 		var u entities.User
-		err = rows.Scan(&u.ISU, &u.CreatedAt, &u.UpdatedAt)
+		err = scanUser(rows, &u)
 		if err != nil {
 			return nil, errors.Wrap(err, "scan user")
 		}
@@ -84,7 +89,7 @@ func (r *Repository) FindByIDs(ctx context.Context, isus []int64) ([]entities.Us
 	}
 
 	query := `
-SELECT isu, created_at, updated_at
+SELECT ` + _userColumns + `
 FROM users
 WHERE isu IN (` + strings.Join(placeholders, ",") + `)`
 
@@ -97,7 +102,7 @@ WHERE isu IN (` + strings.Join(placeholders, ",") + `)`
 	var users []entities.User
 	for rows.Next() {
 		var u entities.User
-		err = rows.Scan(&u.ISU, &u.CreatedAt, &u.UpdatedAt)
+		err = scanUser(rows, &u)
 		if err != nil {
 			return nil, errors.Wrap(err, "scan user")
 		}
@@ -111,3 +116,178 @@ WHERE isu IN (` + strings.Join(placeholders, ",") + `)`
 
 	return users, nil
 }
+
+// DeletedSince returns which of the users had an account deleted at or after the time.
+func (r *Repository) DeletedSince(ctx context.Context, isus []int64, since time.Time) ([]int64, error) {
+	if len(isus) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(isus))
+	args := make([]any, len(isus), len(isus)+1)
+	for i, u := range isus {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = u
+	}
+	args = append(args, since)
+
+	query := `
+SELECT DISTINCT isu
+FROM account_deletions
+WHERE isu IN (` + strings.Join(placeholders, ",") + `) AND deleted_at >= $` + fmt.Sprint(len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "find account deletions")
+	}
+	defer rows.Close()
+
+	var deleted []int64
+	for rows.Next() {
+		var isu int64
+		err = rows.Scan(&isu)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan account deletion")
+		}
+		deleted = append(deleted, isu)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "rows error")
+	}
+
+	return deleted, nil
+}
+
+// Get retrieves a user by ISU. Returns nil if the user does not exist.
+func (r *Repository) Get(ctx context.Context, isu int64) (*entities.User, error) {
+	const query = `
+SELECT ` + _userColumns + `
+FROM users
+WHERE isu = $1`
+
+	var u entities.User
+	err := scanUser(r.db.QueryRow(ctx, query, isu), &u)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "select user")
+	}
+
+	return &u, nil
+}
+
+// SetStatus changes the user's status. Returns false if the user does not exist or already has it.
+func (r *Repository) SetStatus(ctx context.Context, isu int64, status entities.UserStatus) (bool, error) {
+	const query = `
+UPDATE users
+SET status = $2, status_changed_at = NOW(), updated_at = NOW()
+WHERE isu = $1 AND status <> $2`
+
+	tag, err := r.db.Exec(ctx, query, isu, status)
+	if err != nil {
+		return false, errors.Wrap(err, "update user status")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// RecordSync stores the time of a successful or failed sync of the user's schedule.
+func (r *Repository) RecordSync(ctx context.Context, isu int64, ok bool) error {
+	column := "last_failed_at"
+	if ok {
+		column = "last_synced_at"
+	}
+
+	_, err := r.db.Exec(ctx, `UPDATE users SET `+column+` = NOW() WHERE isu = $1`, isu)
+	if err != nil {
+		return errors.Wrap(err, "update user sync time")
+	}
+
+	return nil
+}
+
+// UpdateSettings stores the user's calendar preferences.
+// Returns false if the user does not exist.
+func (r *Repository) UpdateSettings(ctx context.Context, isu int64, settings entities.UserSettings) (bool, error) {
+	const query = `
+UPDATE users
+SET time_zone = $2, locale = $3, compress_recurrence = $4, week_markers = $5,
+    summary_template = $6, description_template = $7, location_template = $8,
+    updated_at = NOW()
+WHERE isu = $1`
+
+	tag, err := r.db.Exec(ctx, query, isu, settings.TimeZone, settings.Locale, settings.CompressRecurrence, settings.WeekMarkers,
+		settings.Templates.Summary, settings.Templates.Description, settings.Templates.Location)
+	if err != nil {
+		return false, errors.Wrap(err, "update user settings")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// _userTables hold data of a user keyed by ISU, caldav_target_objects cascade from caldav_targets.
+// login_states only carry an ISU while a password login is waiting for an answer.
+var _userTables = []string{
+	"user_tokens",
+	"caldav",
+	"lesson_identities",
+	"user_reminders",
+	"schedule_changes",
+	"caldav_targets",
+	"share_tokens",
+	"sessions",
+	"login_states",
+}
+
+// Delete removes the user with all their data and stores the audit record in one transaction.
+// Returns false if there is no such user.
+func (r *Repository) Delete(ctx context.Context, audit entities.AccountDeletion) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "begin tx")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// Deleting the user first locks the row against a concurrent subscription.
+	tag, err := tx.Exec(ctx, `DELETE FROM users WHERE isu = $1`, audit.ISU)
+	if err != nil {
+		return false, errors.Wrap(err, "delete user")
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	for _, table := range _userTables {
+		_, err = tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE isu = $1`, table), audit.ISU)
+		if err != nil {
+			return false, errors.Wrapf(err, "delete from %s", table)
+		}
+	}
+
+	const query = `
+INSERT INTO account_deletions (isu, provider_logout, deleted_at)
+VALUES ($1, $2, $3)`
+
+	_, err = tx.Exec(ctx, query, audit.ISU, audit.ProviderLogout, audit.DeletedAt)
+	if err != nil {
+		return false, errors.Wrap(err, "insert account deletion")
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "commit tx")
+	}
+
+	return true, nil
+}
+
+func scanUser(row pgx.Row, u *entities.User) error {
+	return row.Scan(&u.ISU, &u.Settings.TimeZone, &u.Settings.Locale, &u.Settings.CompressRecurrence, &u.Settings.WeekMarkers,
+		&u.Settings.Templates.Summary, &u.Settings.Templates.Description, &u.Settings.Templates.Location,
+		&u.Status, &u.StatusChangedAt, &u.LastSyncedAt, &u.LastFailedAt, &u.CreatedAt, &u.UpdatedAt)
+}
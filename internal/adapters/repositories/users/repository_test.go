@@ -0,0 +1,126 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/adapters/repositories/caldav"
+	lessonidentities "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/lesson-identities"
+	schedulechanges "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/schedule-changes"
+	usertokens "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/user-tokens"
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/migrations"
+	"github.com/hexarchy/itmo-calendar/pkg/secretbox"
+)
+
+// testDB returns a pool working in a migrated throwaway schema of the database in ITMO_CALENDAR_TEST_POSTGRES.
+func testDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("ITMO_CALENDAR_TEST_POSTGRES")
+	if dsn == "" {
+		t.Skip("ITMO_CALENDAR_TEST_POSTGRES is not set")
+	}
+
+	ctx := context.Background()
+	cfg, err := pgxpool.ParseConfig(dsn)
+	require.NoError(t, err)
+	schema := fmt.Sprintf("users_test_%d", time.Now().UnixNano())
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+
+	db, err := pgxpool.NewWithConfig(ctx, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE")
+		db.Close()
+	})
+
+	_, err = db.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+
+	connString := stdlib.RegisterConnConfig(cfg.ConnConfig)
+	t.Cleanup(func() { stdlib.UnregisterConnConfig(connString) })
+	require.NoError(t, migrations.ApplyMigrations(ctx, zap.NewNop(), connString))
+
+	return db
+}
+
+func TestDeleteWhileSending(t *testing.T) {
+	ctx := context.Background()
+	db := testDB(t)
+	const isu = 123456
+
+	keyring, err := secretbox.NewKeyring("k1", map[string]string{"k1": "secret"})
+	require.NoError(t, err)
+	tokens := usertokens.New(db, keyring, zap.NewNop())
+
+	repo := New(db)
+	_, err = repo.Create(ctx, isu)
+	require.NoError(t, err)
+	require.NoError(t, tokens.UpsertUserTokens(ctx, &entities.UserTokens{
+		ISU:                   isu,
+		AccessToken:           "access",
+		RefreshToken:          "refresh",
+		AccessTokenExpiresAt:  time.Now().Add(time.Hour),
+		RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+	}))
+
+	// A send has read the user and their tokens when the account is deleted.
+	deleted, err := repo.Delete(ctx, entities.AccountDeletion{ISU: isu, DeletedAt: time.Now()})
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	// Everything it writes afterwards is dropped.
+	now := time.Now().UTC()
+	updated, err := tokens.UpdateUserTokens(ctx, &entities.UserTokens{
+		ISU:                   isu,
+		AccessToken:           "access 2",
+		RefreshToken:          "refresh 2",
+		AccessTokenExpiresAt:  now.Add(time.Hour),
+		RefreshTokenExpiresAt: now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	require.NoError(t, lessonidentities.New(db).Upsert(ctx, []entities.LessonIdentity{
+		entities.NewLessonIdentity(isu, "2024-09-02|Databases|Lecture|P3210", "fingerprint", now),
+	}))
+	require.NoError(t, schedulechanges.New(db).Add(ctx, []entities.ScheduleChange{{
+		ISU:       isu,
+		UID:       entities.LessonUID(isu, "2024-09-02|Databases|Lecture|P3210"),
+		Type:      entities.ChangeTypeRemoved,
+		Date:      now,
+		ChangedAt: now,
+	}}))
+	require.NoError(t, caldav.New(db).Create(ctx, entities.CalDav{ISU: isu, ICal: ics.NewCalendar()}))
+
+	for _, table := range append([]string{"users"}, _userTables...) {
+		var count int
+		err = db.QueryRow(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE isu = $1`, table), isu).Scan(&count)
+		require.NoError(t, err, table)
+		assert.Zero(t, count, table)
+	}
+
+	var audit int
+	err = db.QueryRow(ctx, `SELECT COUNT(*) FROM account_deletions WHERE isu = $1`, isu).Scan(&audit)
+	require.NoError(t, err)
+	assert.Equal(t, 1, audit)
+
+	// Sends queued before the deletion are told apart from later ones.
+	since, err := repo.DeletedSince(ctx, []int64{isu, isu + 1}, now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{isu}, since)
+	since, err = repo.DeletedSince(ctx, []int64{isu}, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, since)
+}
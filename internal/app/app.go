@@ -9,6 +9,8 @@ import (
 	"github.com/hexarchy/itmo-calendar/internal/app/container"
 	"github.com/hexarchy/itmo-calendar/internal/config"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/caldav"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/feeds"
 	api "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1"
 	"github.com/hexarchy/itmo-calendar/pkg/shutdown"
 )
@@ -37,16 +39,27 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 		return nil, errors.Wrap(err, "new container")
 	}
 
-	apiHandler, err := api.NewHandler(&app.Container.UseCases, app.Logger)
+	apiHandler, err := api.NewHandler(&app.Container.UseCases, cfg.Calendar.CacheControl, cfg.HTTPServer.LegacyISUPaths, app.Logger)
 	if err != nil {
 		return nil, errors.Wrap(err, "new api handler")
 	}
 
+	options := []http.Option{
+		http.WithAPIHandler(apiHandler),
+		http.WithRouteHandler(feeds.NewHandler(app.Container.UseCases.GetFeed, cfg.Calendar.CacheControl.Header(), app.Logger)),
+		http.WithRouteHandler(caldav.NewHandler(app.Container.UseCases.GetFeedCollection, app.Logger)),
+		http.WithLogger(app.Logger),
+	}
+	if cfg.HTTPServer.LegacyISUPaths {
+		options = append(options, http.WithRouteHandler(
+			http.Deprecated(caldav.NewLegacyHandler(app.Container.UseCases.GetCalendarCollection, app.Logger)),
+		))
+	}
+
 	app.HTTPServer, err = http.New(
 		app.Container,
 		cfg.HTTPServer,
-		http.WithAPIHandler(apiHandler),
-		http.WithLogger(app.Logger),
+		options...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "new http server")
@@ -1,18 +1,30 @@
 package container
 
 import (
+	"github.com/pkg/errors"
+
+	caldavclient "github.com/hexarchy/itmo-calendar/internal/adapters/caldav-client"
 	"github.com/hexarchy/itmo-calendar/internal/adapters/cron"
 	itmoschedule "github.com/hexarchy/itmo-calendar/internal/adapters/itmo-schedule"
 	itmotokens "github.com/hexarchy/itmo-calendar/internal/adapters/itmo-tokens"
 	"github.com/hexarchy/itmo-calendar/internal/adapters/repositories/caldav"
+	caldavtargets "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/caldav-targets"
 	joblocker "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/job-locker"
+	lessonidentities "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/lesson-identities"
+	loginstates "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/login-states"
+	"github.com/hexarchy/itmo-calendar/internal/adapters/repositories/reminders"
+	schedulechanges "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/schedule-changes"
+	"github.com/hexarchy/itmo-calendar/internal/adapters/repositories/sessions"
+	sharetokens "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/share-tokens"
 	usertokens "github.com/hexarchy/itmo-calendar/internal/adapters/repositories/user-tokens"
 	"github.com/hexarchy/itmo-calendar/internal/adapters/repositories/users"
+	"github.com/hexarchy/itmo-calendar/pkg/secretbox"
 )
 
 type Adapters struct {
 	ITMOSchedule *itmoschedule.Client
 	ITMOTokens   *itmotokens.Client
+	CalDavClient *caldavclient.Client
 
 	Cron *cron.Adapter
 
@@ -20,6 +32,14 @@ type Adapters struct {
 	Users      *users.Repository
 	JobLocker  *joblocker.Repository
 	CalDav     *caldav.Repository
+
+	LessonIdentities *lessonidentities.Repository
+	Reminders        *reminders.Repository
+	ScheduleChanges  *schedulechanges.Repository
+	CalDavTargets    *caldavtargets.Repository
+	ShareTokens      *sharetokens.Repository
+	Sessions         *sessions.Repository
+	LoginStates      *loginstates.Repository
 }
 
 func (c *Container) initAdapters() error {
@@ -29,12 +49,25 @@ func (c *Container) initAdapters() error {
 	c.Adapters.ITMOTokens = itmotokens.New(
 		c.Config.ITMO.ClientID,
 		c.Config.ITMO.RedirectURI,
+		c.Config.ITMO.CallbackURI,
 		c.Config.ITMO.ProviderURL,
 		c.Logger,
 	)
+	c.Adapters.CalDavClient = caldavclient.New(
+		c.Config.Calendar.PushTimeout,
+		c.Config.Calendar.PushAllowPrivate,
+	)
+	tokenSecrets, err := c.Config.Secrets.TokenSecrets()
+	if err != nil {
+		return errors.Wrap(err, "load token keys")
+	}
+	tokenKeyring, err := secretbox.NewKeyring(c.Config.Secrets.TokenKeyID, tokenSecrets)
+	if err != nil {
+		return errors.Wrap(err, "new token keyring")
+	}
 	c.Adapters.UserTokens = usertokens.New(
 		c.Infra.Postgres,
-		c.Config.Secrets.JWTSecret,
+		tokenKeyring,
 		c.Logger,
 	)
 	c.Adapters.Users = users.New(
@@ -51,6 +84,30 @@ func (c *Container) initAdapters() error {
 	c.Adapters.CalDav = caldav.New(
 		c.Infra.Postgres,
 	)
+	c.Adapters.LessonIdentities = lessonidentities.New(
+		c.Infra.Postgres,
+	)
+	c.Adapters.Reminders = reminders.New(
+		c.Infra.Postgres,
+	)
+	c.Adapters.ScheduleChanges = schedulechanges.New(
+		c.Infra.Postgres,
+	)
+	c.Adapters.CalDavTargets = caldavtargets.New(
+		c.Infra.Postgres,
+		tokenKeyring,
+		c.Logger,
+	)
+	c.Adapters.ShareTokens = sharetokens.New(
+		c.Infra.Postgres,
+	)
+	c.Adapters.Sessions = sessions.New(
+		c.Infra.Postgres,
+	)
+	c.Adapters.LoginStates = loginstates.New(
+		c.Infra.Postgres,
+		tokenKeyring,
+	)
 
 	return nil
 }
@@ -1,11 +1,23 @@
 package container
 
 import (
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
 	"github.com/hexarchy/itmo-calendar/internal/services/caldav"
+	"github.com/hexarchy/itmo-calendar/internal/services/changes"
 	"github.com/hexarchy/itmo-calendar/internal/services/cron"
 	"github.com/hexarchy/itmo-calendar/internal/services/ical"
+	"github.com/hexarchy/itmo-calendar/internal/services/identities"
+	"github.com/hexarchy/itmo-calendar/internal/services/keys"
+	"github.com/hexarchy/itmo-calendar/internal/services/logins"
+	"github.com/hexarchy/itmo-calendar/internal/services/meetings"
+	"github.com/hexarchy/itmo-calendar/internal/services/reminders"
 	"github.com/hexarchy/itmo-calendar/internal/services/schedules"
+	"github.com/hexarchy/itmo-calendar/internal/services/sessions"
+	"github.com/hexarchy/itmo-calendar/internal/services/shares"
 	"github.com/hexarchy/itmo-calendar/internal/services/users"
+	"github.com/hexarchy/itmo-calendar/pkg/jwt"
 )
 
 type Services struct {
@@ -14,6 +26,15 @@ type Services struct {
 	ICal      *ical.Service
 	Cron      *cron.Service
 	CalDav    *caldav.Service
+
+	Identities *identities.Service
+	Reminders  *reminders.Service
+	Changes    *changes.Service
+	Shares     *shares.Service
+	Meetings   *meetings.Service
+	Sessions   *sessions.Service
+	Keys       *keys.Service
+	Logins     *logins.Service
 }
 
 func (c *Container) initServices() error {
@@ -31,10 +52,80 @@ func (c *Container) initServices() error {
 		c.Adapters.Cron,
 	)
 
-	c.Services.ICal = ical.New()
+	academic, err := entities.ParseAcademicCalendar(
+		c.Config.Calendar.Academic.SemesterStarts,
+		c.Config.Calendar.Academic.Holidays,
+		c.Config.Calendar.Academic.Sessions,
+	)
+	if err != nil {
+		return errors.Wrap(err, "parse academic calendar")
+	}
+
+	c.Services.ICal = ical.New(
+		c.Config.Calendar.TimeZone,
+		c.Config.Calendar.CancelledGracePeriod,
+		c.Config.Cron.SchedulePreparationInterval,
+		map[entities.LessonKind]string{
+			entities.LessonKindLecture:  c.Config.Calendar.Colors.Lecture,
+			entities.LessonKindPractice: c.Config.Calendar.Colors.Practice,
+			entities.LessonKindLab:      c.Config.Calendar.Colors.Lab,
+			entities.LessonKindOther:    c.Config.Calendar.Colors.Other,
+		},
+		academic,
+	)
 
 	c.Services.CalDav = caldav.New(
 		c.Adapters.CalDav,
+		c.Adapters.CalDavTargets,
+		c.Adapters.CalDavClient,
+	)
+
+	c.Services.Identities = identities.New(
+		c.Adapters.LessonIdentities,
+	)
+
+	c.Services.Reminders = reminders.New(
+		c.Adapters.Reminders,
+	)
+
+	c.Services.Changes = changes.New(
+		c.Adapters.ScheduleChanges,
+		c.Config.Calendar.ChangeLogRetention,
+	)
+
+	c.Services.Shares = shares.New(
+		c.Adapters.ShareTokens,
+	)
+
+	c.Services.Meetings = meetings.New(
+		c.Config.Calendar.TimeZone,
+	)
+
+	previousKeys, err := c.Config.Secrets.PreviousJWTKeys()
+	if err != nil {
+		return errors.Wrap(err, "parse previous JWT keys")
+	}
+
+	c.Services.Sessions = sessions.New(
+		c.Adapters.Sessions,
+		jwt.NewSigner(c.Config.Secrets.JWTKeyID, c.Config.Secrets.JWTSecret, previousKeys),
+		c.Config.Auth.AccessTokenTTL,
+		c.Config.Auth.RefreshTokenTTL,
+	)
+
+	c.Services.Keys = keys.New(
+		map[string]keys.Repo{
+			"user_tokens":    c.Adapters.UserTokens,
+			"caldav_targets": c.Adapters.CalDavTargets,
+		},
+		c.Config.Secrets.TokenKeyID,
+	)
+
+	c.Services.Logins = logins.New(
+		c.Adapters.LoginStates,
+		c.Adapters.ITMOTokens,
+		c.Adapters.UserTokens,
+		c.Config.Auth.LoginTTL,
 	)
 
 	return nil
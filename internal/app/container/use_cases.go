@@ -1,19 +1,67 @@
 package container
 
 import (
+	"github.com/hexarchy/itmo-calendar/internal/use-cases/authenticate"
+	deletecaldavtarget "github.com/hexarchy/itmo-calendar/internal/use-cases/delete-caldav-target"
+	deletesubscription "github.com/hexarchy/itmo-calendar/internal/use-cases/delete-subscription"
+	findmeetingslots "github.com/hexarchy/itmo-calendar/internal/use-cases/find-meeting-slots"
+	getcaldavtarget "github.com/hexarchy/itmo-calendar/internal/use-cases/get-caldav-target"
+	getcalendarcollection "github.com/hexarchy/itmo-calendar/internal/use-cases/get-calendar-collection"
+	getfeed "github.com/hexarchy/itmo-calendar/internal/use-cases/get-feed"
+	getfeedcollection "github.com/hexarchy/itmo-calendar/internal/use-cases/get-feed-collection"
+	getfreebusy "github.com/hexarchy/itmo-calendar/internal/use-cases/get-free-busy"
 	getical "github.com/hexarchy/itmo-calendar/internal/use-cases/get-ical"
+	getreminders "github.com/hexarchy/itmo-calendar/internal/use-cases/get-reminders"
 	getschedule "github.com/hexarchy/itmo-calendar/internal/use-cases/get-schedule"
+	getschedulechanges "github.com/hexarchy/itmo-calendar/internal/use-cases/get-schedule-changes"
+	getsettings "github.com/hexarchy/itmo-calendar/internal/use-cases/get-settings"
+	getsyncstatus "github.com/hexarchy/itmo-calendar/internal/use-cases/get-sync-status"
+	"github.com/hexarchy/itmo-calendar/internal/use-cases/logout"
+	maintaintokens "github.com/hexarchy/itmo-calendar/internal/use-cases/maintain-tokens"
 	preparesendschedule "github.com/hexarchy/itmo-calendar/internal/use-cases/prepare-send-schedule"
+	reencrypttokens "github.com/hexarchy/itmo-calendar/internal/use-cases/reencrypt-tokens"
+	refreshsession "github.com/hexarchy/itmo-calendar/internal/use-cases/refresh-session"
+	reporttokenkeys "github.com/hexarchy/itmo-calendar/internal/use-cases/report-token-keys"
+	revokesharetoken "github.com/hexarchy/itmo-calendar/internal/use-cases/revoke-share-token"
+	rotatesharetoken "github.com/hexarchy/itmo-calendar/internal/use-cases/rotate-share-token"
 	sendschedule "github.com/hexarchy/itmo-calendar/internal/use-cases/send-schedule"
+	startlogin "github.com/hexarchy/itmo-calendar/internal/use-cases/start-login"
 	subscribeschedule "github.com/hexarchy/itmo-calendar/internal/use-cases/subscribe-schedule"
+	updatecaldavtarget "github.com/hexarchy/itmo-calendar/internal/use-cases/update-caldav-target"
+	updatereminders "github.com/hexarchy/itmo-calendar/internal/use-cases/update-reminders"
+	updatesettings "github.com/hexarchy/itmo-calendar/internal/use-cases/update-settings"
 )
 
 type UseCases struct {
-	PrepareSendSchedule *preparesendschedule.UseCase
-	SendSchedule        *sendschedule.UseCase
-	SubscirbeSchedule   *subscribeschedule.UseCase
-	GetICal             *getical.UseCase
-	GetSchedule         *getschedule.UseCase
+	PrepareSendSchedule   *preparesendschedule.UseCase
+	SendSchedule          *sendschedule.UseCase
+	SubscirbeSchedule     *subscribeschedule.UseCase
+	GetICal               *getical.UseCase
+	GetCalendarCollection *getcalendarcollection.UseCase
+	GetSchedule           *getschedule.UseCase
+	GetScheduleChanges    *getschedulechanges.UseCase
+	GetSettings           *getsettings.UseCase
+	UpdateSettings        *updatesettings.UseCase
+	GetReminders          *getreminders.UseCase
+	UpdateReminders       *updatereminders.UseCase
+	GetCalDavTarget       *getcaldavtarget.UseCase
+	UpdateCalDavTarget    *updatecaldavtarget.UseCase
+	DeleteCalDavTarget    *deletecaldavtarget.UseCase
+	GetFreeBusy           *getfreebusy.UseCase
+	RotateShareToken      *rotatesharetoken.UseCase
+	RevokeShareToken      *revokesharetoken.UseCase
+	FindMeetingSlots      *findmeetingslots.UseCase
+	GetFeed               *getfeed.UseCase
+	GetFeedCollection     *getfeedcollection.UseCase
+	Authenticate          *authenticate.UseCase
+	RefreshSession        *refreshsession.UseCase
+	Logout                *logout.UseCase
+	DeleteSubscription    *deletesubscription.UseCase
+	ReencryptTokens       *reencrypttokens.UseCase
+	ReportTokenKeys       *reporttokenkeys.UseCase
+	StartLogin            *startlogin.UseCase
+	MaintainTokens        *maintaintokens.UseCase
+	GetSyncStatus         *getsyncstatus.UseCase
 }
 
 func (c *Container) initUseCases() error {
@@ -28,14 +76,22 @@ func (c *Container) initUseCases() error {
 		c.Services.Users,
 		c.Services.ICal,
 		c.Services.CalDav,
+		c.Services.Identities,
+		c.Services.Reminders,
+		c.Services.Changes,
 		c.Logger,
 	)
 
 	c.UseCases.SubscirbeSchedule = subscribeschedule.New(
 		c.Services.Schedules,
+		c.Services.Logins,
 		c.Services.Users,
 		c.Services.ICal,
+		c.Services.Reminders,
 		c.Services.CalDav,
+		c.Services.Identities,
+		c.Services.Shares,
+		c.Services.Sessions,
 		c.Logger,
 	)
 
@@ -43,10 +99,137 @@ func (c *Container) initUseCases() error {
 		c.Services.CalDav,
 	)
 
+	c.UseCases.GetCalendarCollection = getcalendarcollection.New(
+		c.Services.CalDav,
+		c.Services.ICal,
+	)
+
 	c.UseCases.GetSchedule = getschedule.New(
 		c.Services.CalDav,
 		c.Services.ICal,
 	)
 
+	c.UseCases.GetScheduleChanges = getschedulechanges.New(
+		c.Services.CalDav,
+		c.Services.ICal,
+		c.Services.Changes,
+	)
+
+	c.UseCases.GetSettings = getsettings.New(
+		c.Services.Users,
+	)
+
+	c.UseCases.UpdateSettings = updatesettings.New(
+		c.Services.Users,
+		c.Services.ICal,
+		c.Services.Cron,
+		c.Logger,
+	)
+
+	c.UseCases.GetReminders = getreminders.New(
+		c.Services.Users,
+		c.Services.Reminders,
+	)
+
+	c.UseCases.UpdateReminders = updatereminders.New(
+		c.Services.Users,
+		c.Services.Reminders,
+		c.Services.Cron,
+		c.Logger,
+	)
+
+	c.UseCases.GetCalDavTarget = getcaldavtarget.New(
+		c.Services.Users,
+		c.Services.CalDav,
+	)
+
+	c.UseCases.UpdateCalDavTarget = updatecaldavtarget.New(
+		c.Services.Users,
+		c.Services.CalDav,
+		c.Services.Cron,
+		c.Logger,
+	)
+
+	c.UseCases.DeleteCalDavTarget = deletecaldavtarget.New(
+		c.Services.CalDav,
+	)
+
+	c.UseCases.GetFreeBusy = getfreebusy.New(
+		c.Services.Shares,
+		c.Services.CalDav,
+		c.Services.ICal,
+	)
+
+	c.UseCases.RotateShareToken = rotatesharetoken.New(
+		c.Services.Users,
+		c.Services.Shares,
+	)
+
+	c.UseCases.RevokeShareToken = revokesharetoken.New(
+		c.Services.Shares,
+	)
+
+	c.UseCases.FindMeetingSlots = findmeetingslots.New(
+		c.Services.Shares,
+		c.Services.CalDav,
+		c.Services.ICal,
+		c.Services.Meetings,
+	)
+
+	c.UseCases.GetFeed = getfeed.New(
+		c.Services.Shares,
+		c.Services.CalDav,
+	)
+
+	c.UseCases.GetFeedCollection = getfeedcollection.New(
+		c.Services.Shares,
+		c.Services.CalDav,
+		c.Services.ICal,
+	)
+
+	c.UseCases.Authenticate = authenticate.New(
+		c.Services.Sessions,
+	)
+
+	c.UseCases.RefreshSession = refreshsession.New(
+		c.Services.Sessions,
+	)
+
+	c.UseCases.Logout = logout.New(
+		c.Services.Sessions,
+	)
+
+	c.UseCases.DeleteSubscription = deletesubscription.New(
+		c.Services.Users,
+		c.Services.Schedules,
+		c.Logger,
+	)
+
+	c.UseCases.ReencryptTokens = reencrypttokens.New(
+		c.Services.Keys,
+		c.Logger,
+	)
+
+	c.UseCases.ReportTokenKeys = reporttokenkeys.New(
+		c.Services.Keys,
+	)
+
+	c.UseCases.StartLogin = startlogin.New(
+		c.Services.Logins,
+	)
+
+	c.UseCases.MaintainTokens = maintaintokens.New(
+		c.Services.Schedules,
+		c.Services.Users,
+		c.Services.Cron,
+		c.Config.Cron.TokenRefreshAhead,
+		c.Logger,
+	)
+
+	c.UseCases.GetSyncStatus = getsyncstatus.New(
+		c.Services.Users,
+		c.Services.Schedules,
+	)
+
 	return nil
 }
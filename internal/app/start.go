@@ -41,7 +41,7 @@ func (a *App) Start(ctx context.Context) error {
 		runner := cronjob.New(a.Container.UseCases.PrepareSendSchedule,
 			a.Container.Adapters.JobLocker,
 			a.Cfg.RabbitMQ.Queues.CronProcessScheduleQueue,
-			1*time.Minute, // TODO: make it configurable.
+			a.Cfg.Cron.SchedulePreparationInterval,
 			a.Logger.With(zap.String("component", "cron-scheduler")),
 		)
 		runner.Start(ctx)
@@ -49,6 +49,30 @@ func (a *App) Start(ctx context.Context) error {
 		return nil
 	}
 
+	runners["token-reencryption"] = func(ctx context.Context) error {
+		a.Logger.Info("Starting token re-encryption")
+		runner := cronjob.New(a.Container.UseCases.ReencryptTokens,
+			a.Container.Adapters.JobLocker,
+			"reencrypt_user_tokens",
+			a.Cfg.Cron.TokenReencryptionInterval,
+			a.Logger.With(zap.String("component", "token-reencryption")),
+		)
+		runner.Start(ctx)
+		return nil
+	}
+
+	runners["token-maintenance"] = func(ctx context.Context) error {
+		a.Logger.Info("Starting token maintenance")
+		runner := cronjob.New(a.Container.UseCases.MaintainTokens,
+			a.Container.Adapters.JobLocker,
+			"maintain_user_tokens",
+			a.Cfg.Cron.TokenMaintenanceInterval,
+			a.Logger.With(zap.String("component", "token-maintenance")),
+		)
+		runner.Start(ctx)
+		return nil
+	}
+
 	runners["send-schedule"] = func(ctx context.Context) error {
 		a.Logger.Info("Starting workers")
 		err := a.Container.Workers.RabbitMQ.SendSchedule.Start(ctx)
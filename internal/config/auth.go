@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+type Auth struct {
+	AccessTokenTTL  time.Duration `path:"access_token_ttl" default:"15m" desc:"Lifetime of session access tokens"`
+	RefreshTokenTTL time.Duration `path:"refresh_token_ttl" default:"720h" desc:"Lifetime of session refresh tokens"`
+	LoginTTL        time.Duration `path:"login_ttl" default:"10m" desc:"Time to complete a browser login at ITMO ID"`
+}
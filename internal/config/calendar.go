@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+type Calendar struct {
+	TimeZone             string        `path:"time_zone" default:"Europe/Moscow" desc:"Default time zone of generated calendars"`
+	CancelledGracePeriod time.Duration `path:"cancelled_grace_period" default:"168h" desc:"How long removed lessons stay in the feed as cancelled"`
+	ChangeLogRetention   time.Duration `path:"change_log_retention" default:"720h" desc:"How long schedule changes are kept for sync tokens"`
+	Colors               *Colors       `path:"colors" desc:"CSS3 color names of lessons by type"`
+	Academic             *Academic     `path:"academic" desc:"Academic calendar for week numbering"`
+	CacheControl         *CacheControl `path:"cache_control" desc:"Cache-Control of the iCal feed"`
+	PushTimeout          time.Duration `path:"push_timeout" default:"10s" desc:"Timeout of requests to users' CalDAV servers"`
+	PushAllowPrivate     bool          `path:"push_allow_private" default:"false" desc:"Allow users' CalDAV servers on loopback and private networks"`
+}
+
+type CacheControl struct {
+	MaxAge  time.Duration `path:"max_age" default:"5m" desc:"How long clients may reuse a fetched feed without revalidating"`
+	Private bool          `path:"private" default:"true" desc:"Forbid shared caches from storing feeds"`
+}
+
+// Header returns the Cache-Control header value.
+func (c *CacheControl) Header() string {
+	visibility := "public"
+	if c.Private {
+		visibility = "private"
+	}
+
+	return fmt.Sprintf("%s, max-age=%d", visibility, int(c.MaxAge.Seconds()))
+}
+
+type Academic struct {
+	SemesterStarts []string `path:"semester_starts" desc:"Semester start dates as YYYY-MM-DD"`
+	Holidays       []string `path:"holidays" desc:"Holiday periods as YYYY-MM-DD/YYYY-MM-DD"`
+	Sessions       []string `path:"sessions" desc:"Exam session periods as YYYY-MM-DD/YYYY-MM-DD"`
+}
+
+type Colors struct {
+	Lecture  string `path:"lecture" default:"royalblue" desc:"Color of lectures"`
+	Practice string `path:"practice" default:"seagreen" desc:"Color of practices"`
+	Lab      string `path:"lab" default:"darkorange" desc:"Color of labs"`
+	Other    string `path:"other" default:"slategray" desc:"Color of other lessons"`
+}
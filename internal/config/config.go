@@ -10,4 +10,7 @@ type Config struct {
 	ITMO       *ITMO       `path:"itmo"`
 	TLS        *TLS        `path:"tls"`
 	Secrets    *Secrets    `path:"secret"`
+	Auth       *Auth       `path:"auth"`
+	Calendar   *Calendar   `path:"calendar"`
+	Cron       *Cron       `path:"cron"`
 }
@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type Cron struct {
+	SchedulePreparationInterval time.Duration `path:"schedule_preparation_interval" default:"1m" desc:"How often calendars of all users are refreshed"`
+	TokenReencryptionInterval   time.Duration `path:"token_reencryption_interval" default:"1h" desc:"How often stored OAuth tokens are re-encrypted with the current key"`
+	TokenMaintenanceInterval    time.Duration `path:"token_maintenance_interval" default:"1h" desc:"How often ITMO ID tokens close to expiry are refreshed"`
+	TokenRefreshAhead           time.Duration `path:"token_refresh_ahead" default:"72h" desc:"How long before their expiry ITMO ID refresh tokens are refreshed"`
+}
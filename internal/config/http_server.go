@@ -14,4 +14,8 @@ type HTTPServer struct {
 	WriteTimeout time.Duration `path:"write_timeout" default:"5s"`
 	IdleTimeout  time.Duration `path:"idle_timeout" default:"60s"`
 	EnableHTTP2  bool          `path:"enable_http2" default:"true"`
+
+	// LegacyISUPaths keeps serving calendars by bare ISU, e.g. /api/v1/{isu}/ical and /caldav/{isu}/.
+	// ISUs are sequential, so these paths expose everybody's schedule and are deprecated.
+	LegacyISUPaths bool `path:"legacy_isu_paths" default:"false"`
 }
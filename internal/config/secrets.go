@@ -1,7 +1,90 @@
 package config
 
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 // Secrets contains secret keys and tokens.
 type Secrets struct {
 	// JWTSecret is used to sign JWT tokens.
 	JWTSecret string `path:"jwt_secret" default:"secret" secret:"true" desc:"JWT secret key"`
+	// JWTKeyID is put into the kid header of issued tokens.
+	JWTKeyID string `path:"jwt_key_id" default:"k1" desc:"ID of the JWT secret key"`
+	// JWTPreviousKeys keep verifying tokens signed before a key rotation.
+	JWTPreviousKeys []string `path:"jwt_previous_keys" desc:"Retired JWT keys as kid:secret, still accepted for verification"`
+
+	// TokenKeyID selects the key stored OAuth tokens and CalDAV passwords are encrypted with.
+	// Empty keeps the legacy key, values encrypted with it carry no key ID.
+	TokenKeyID string `path:"token_key_id" default:"" desc:"ID of the key encrypting stored OAuth tokens, empty for the legacy key"`
+	// LegacyTokenSecret is the legacy key. Values without a key ID were encrypted with the JWT secret of the time,
+	// so it falls back to JWTSecret, and has to be set to that secret before JWTSecret is rotated.
+	LegacyTokenSecret string `path:"legacy_token_secret" default:"" secret:"true" desc:"Secret of stored values without a key ID, the JWT secret they were encrypted with"`
+	// TokenKeys and TokenKeysFile list every key stored tokens may be encrypted with.
+	TokenKeys     []string `path:"token_keys" desc:"Keys of stored OAuth tokens as kid:secret"`
+	TokenKeysFile string   `path:"token_keys_file" desc:"File with one kid:secret key of stored OAuth tokens per line"`
+}
+
+// PreviousJWTKeys returns the retired JWT keys by ID.
+func (s *Secrets) PreviousJWTKeys() (map[string]string, error) {
+	keys, err := parseKeys(s.JWTPreviousKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "previous JWT keys")
+	}
+	if _, ok := keys[s.JWTKeyID]; ok {
+		return nil, errors.Errorf("JWT key %q is both current and previous", s.JWTKeyID)
+	}
+
+	return keys, nil
+}
+
+// TokenSecrets returns the keys of stored secrets by ID, the legacy key has an empty ID.
+func (s *Secrets) TokenSecrets() (map[string]string, error) {
+	entries := s.TokenKeys
+	if s.TokenKeysFile != "" {
+		content, err := os.ReadFile(s.TokenKeysFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "read token keys file")
+		}
+		for _, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" && !strings.HasPrefix(line, "#") {
+				entries = append(entries, line)
+			}
+		}
+	}
+
+	keys, err := parseKeys(entries)
+	if err != nil {
+		return nil, errors.Wrap(err, "token keys")
+	}
+
+	keys[""] = s.LegacyTokenSecret
+	if keys[""] == "" {
+		if len(s.JWTPreviousKeys) > 0 {
+			// The current JWT secret is not the one legacy values were encrypted with.
+			return nil, errors.New("legacy_token_secret must be set once the JWT key was rotated")
+		}
+		keys[""] = s.JWTSecret
+	}
+
+	return keys, nil
+}
+
+func parseKeys(entries []string) (map[string]string, error) {
+	keys := make(map[string]string, len(entries))
+	for i, entry := range entries {
+		id, secret, ok := strings.Cut(entry, ":")
+		if !ok || id == "" || secret == "" {
+			return nil, errors.Errorf("invalid key #%d, expected kid:secret", i)
+		}
+		if _, ok = keys[id]; ok {
+			return nil, errors.Errorf("duplicate key %q", id)
+		}
+		keys[id] = secret
+	}
+
+	return keys, nil
 }
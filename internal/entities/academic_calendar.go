@@ -0,0 +1,149 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const _dateLayout = "2006-01-02"
+
+// WeekPeriod tells what kind of week an academic week is.
+type WeekPeriod string
+
+const (
+	WeekPeriodStudy   WeekPeriod = "study"
+	WeekPeriodHoliday WeekPeriod = "holiday"
+	WeekPeriodSession WeekPeriod = "session"
+)
+
+// AcademicWeek is a week of a semester.
+type AcademicWeek struct {
+	// Monday is the first day of the week.
+	Monday time.Time `json:"monday"`
+	// Number counts weeks from the one the semester starts in, starting with 1.
+	Number int `json:"number"`
+	// Odd is the parity of the week number ITMO schedules alternate on.
+	Odd    bool       `json:"odd"`
+	Period WeekPeriod `json:"period"`
+}
+
+// DatePeriod is an inclusive range of dates.
+type DatePeriod struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// covers reports whether every day from one date to another lies within the period.
+func (p DatePeriod) covers(from, to time.Time) bool {
+	return !from.Before(p.From) && !to.After(p.To)
+}
+
+// AcademicCalendar holds semester starts and the periods without regular classes.
+type AcademicCalendar struct {
+	SemesterStarts []time.Time  `json:"semester_starts"`
+	Holidays       []DatePeriod `json:"holidays"`
+	Sessions       []DatePeriod `json:"sessions"`
+}
+
+// ParseAcademicCalendar parses semester starts given as YYYY-MM-DD and holiday and session
+// periods given as YYYY-MM-DD/YYYY-MM-DD.
+func ParseAcademicCalendar(semesterStarts, holidays, sessions []string) (AcademicCalendar, error) {
+	var calendar AcademicCalendar
+
+	for _, value := range semesterStarts {
+		start, err := time.Parse(_dateLayout, strings.TrimSpace(value))
+		if err != nil {
+			return AcademicCalendar{}, errors.Wrapf(err, "parse semester start %q", value)
+		}
+		calendar.SemesterStarts = append(calendar.SemesterStarts, start)
+	}
+
+	var err error
+	calendar.Holidays, err = parseDatePeriods(holidays)
+	if err != nil {
+		return AcademicCalendar{}, errors.Wrap(err, "parse holidays")
+	}
+
+	calendar.Sessions, err = parseDatePeriods(sessions)
+	if err != nil {
+		return AcademicCalendar{}, errors.Wrap(err, "parse sessions")
+	}
+
+	return calendar, nil
+}
+
+func parseDatePeriods(values []string) ([]DatePeriod, error) {
+	periods := make([]DatePeriod, 0, len(values))
+	for _, value := range values {
+		fromValue, toValue, ok := strings.Cut(strings.TrimSpace(value), "/")
+		if !ok {
+			return nil, errors.Errorf("period %q is not FROM/TO", value)
+		}
+
+		from, err := time.Parse(_dateLayout, fromValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse period %q", value)
+		}
+
+		to, err := time.Parse(_dateLayout, toValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse period %q", value)
+		}
+
+		if to.Before(from) {
+			return nil, errors.Errorf("period %q ends before it starts", value)
+		}
+
+		periods = append(periods, DatePeriod{From: from, To: to})
+	}
+
+	return periods, nil
+}
+
+// WeekOf returns the academic week containing the date, or nil before the first semester.
+// A week lies in a holiday or session period when the period covers Monday to Saturday.
+func (c AcademicCalendar) WeekOf(date time.Time) *AcademicWeek {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	monday := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+
+	// A semester starting mid-week counts that week as its first.
+	sunday := monday.AddDate(0, 0, 6)
+
+	var start time.Time
+	for _, semesterStart := range c.SemesterStarts {
+		if !semesterStart.After(sunday) && semesterStart.After(start) {
+			start = semesterStart
+		}
+	}
+	if start.IsZero() {
+		return nil
+	}
+
+	firstMonday := start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+	number := int(monday.Sub(firstMonday).Hours())/24/7 + 1
+
+	week := &AcademicWeek{
+		Monday: monday,
+		Number: number,
+		Odd:    number%2 == 1,
+		Period: WeekPeriodStudy,
+	}
+
+	saturday := sunday.AddDate(0, 0, -1)
+	for _, session := range c.Sessions {
+		if session.covers(monday, saturday) {
+			week.Period = WeekPeriodSession
+			return week
+		}
+	}
+	for _, holiday := range c.Holidays {
+		if holiday.covers(monday, saturday) {
+			week.Period = WeekPeriodHoliday
+			return week
+		}
+	}
+
+	return week
+}
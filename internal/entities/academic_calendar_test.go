@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcademicCalendarWeekOf(t *testing.T) {
+	calendar, err := ParseAcademicCalendar(
+		[]string{"2025-09-01", "2026-02-04"},
+		[]string{"2025-12-29/2026-01-11"},
+		[]string{"2026-01-12/2026-02-01"},
+	)
+	require.NoError(t, err)
+
+	date := func(value string) time.Time {
+		d, err := time.Parse(_dateLayout, value)
+		require.NoError(t, err)
+		return d
+	}
+
+	assert.Nil(t, calendar.WeekOf(date("2025-08-31")))
+
+	week := calendar.WeekOf(date("2025-10-16"))
+	require.NotNil(t, week)
+	assert.Equal(t, date("2025-10-13"), week.Monday)
+	assert.Equal(t, 7, week.Number)
+	assert.True(t, week.Odd)
+	assert.Equal(t, WeekPeriodStudy, week.Period)
+
+	assert.Equal(t, WeekPeriodHoliday, calendar.WeekOf(date("2026-01-03")).Period)
+	assert.Equal(t, WeekPeriodSession, calendar.WeekOf(date("2026-01-20")).Period)
+
+	// The spring semester starts on a Wednesday, its week is the first one.
+	week = calendar.WeekOf(date("2026-02-09"))
+	assert.Equal(t, 2, week.Number)
+	assert.False(t, week.Odd)
+
+	assert.Equal(t, 1, calendar.WeekOf(date("2026-02-02")).Number)
+
+	_, err = ParseAcademicCalendar(nil, []string{"2026-01-11/2025-12-29"}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+// AccountDeletion is the audit record left once a user deletes their account.
+// It holds nothing but the fact of the deletion.
+type AccountDeletion struct {
+	ISU int64 `json:"isu"`
+	// ProviderLogout tells whether the ITMO ID refresh token was revoked.
+	ProviderLogout bool `json:"provider_logout"`
+	// DeletedAt is the deletion timestamp.
+	DeletedAt time.Time `json:"deleted_at"`
+}
@@ -1,10 +1,16 @@
 package entities
 
 import (
+	"time"
+
 	ics "github.com/arran4/golang-ical"
 )
 
 type CalDav struct {
 	ISU  int64         `json:"isu"`
 	ICal *ics.Calendar `json:"ical"`
+	// ETag is the strong validator of the serialized calendar.
+	ETag string `json:"etag"`
+	// UpdatedAt is the time the calendar content last changed.
+	UpdatedAt time.Time `json:"updated_at"`
 }
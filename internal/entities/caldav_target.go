@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrRemoteConflict is returned when a remote resource changed since it was last written.
+	ErrRemoteConflict = errors.New("remote resource changed")
+	// ErrRemoteNotFound is returned when a remote resource does not exist.
+	ErrRemoteNotFound = errors.New("remote resource not found")
+	// ErrForbiddenAddress is returned when a remote host is on a loopback, private or otherwise non-public address.
+	ErrForbiddenAddress = errors.New("remote address is not public")
+)
+
+// CalDavTarget is an external CalDAV collection the user's lessons are pushed to.
+type CalDavTarget struct {
+	ISU int64 `json:"isu"`
+	// URL is the address of the calendar collection.
+	URL       string    `json:"url"`
+	Username  string    `json:"username"`
+	Password  string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RemoteObject is a calendar object written to a user's CalDAV target.
+type RemoteObject struct {
+	ISU int64  `json:"isu"`
+	UID string `json:"uid"`
+	// Href is the URL of the resource on the target server.
+	Href string `json:"href"`
+	// ETag is the entity tag the server returned for the last write.
+	ETag string `json:"etag"`
+	// Hash is the CalendarObject.ETag of the content last written.
+	Hash string `json:"hash"`
+	// Deleted is set once the user deleted the resource on the server, it is not written again.
+	Deleted bool `json:"deleted"`
+}
+
+// PushResult counts what a push did to the user's CalDAV target.
+type PushResult struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Deleted   int `json:"deleted"`
+	Unchanged int `json:"unchanged"`
+	// Conflicts are resources edited on the server since the last push, they are left alone.
+	Conflicts int `json:"conflicts"`
+}
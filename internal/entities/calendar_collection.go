@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+// CalendarCollection is a user's calendar served as a CalDAV collection.
+type CalendarCollection struct {
+	ISU         int64  `json:"isu"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// CTag changes whenever any object of the collection changes.
+	CTag    string           `json:"ctag"`
+	Objects []CalendarObject `json:"objects"`
+}
+
+// CalendarObject is a single calendar resource: an event together with its recurrence overrides.
+type CalendarObject struct {
+	UID  string `json:"uid"`
+	Data string `json:"data"`
+	ETag string `json:"etag"`
+	// Start and End bound all occurrences of the event.
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Object returns the object with the given UID, nil if there is none.
+func (c *CalendarCollection) Object(uid string) *CalendarObject {
+	for i := range c.Objects {
+		if c.Objects[i].UID == uid {
+			return &c.Objects[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package entities
+
+import "time"
+
+// CalendarOptions holds per-user settings used when generating a calendar.
+type CalendarOptions struct {
+	// Identities maps lesson slots to their persistent identities.
+	Identities map[string]LessonIdentity
+	// TimeZone is the IANA zone to render times in, empty for the service default.
+	TimeZone string
+	// Reminders are the user's alarm rules in priority order.
+	Reminders []ReminderRule
+	// CompressRecurrence emits weekly and bi-weekly lessons as recurring events.
+	CompressRecurrence bool
+	// Locale is the language of labels and lesson types, empty for the default.
+	Locale string
+	// WeekMarkers adds an all-day event naming the academic week on every Monday.
+	WeekMarkers bool
+	// Templates override the rendering of SUMMARY, DESCRIPTION and LOCATION.
+	Templates EventTemplates
+	// ReauthRequiredSince adds an all-day event on that day asking the user to subscribe again.
+	ReauthRequiredSince *time.Time
+}
+
+// CalendarOptions returns the options of the user's calendar from their settings.
+func (u User) CalendarOptions(identities map[string]LessonIdentity, reminders []ReminderRule) CalendarOptions {
+	return CalendarOptions{
+		Identities: identities,
+		TimeZone:   u.Settings.TimeZone,
+		Locale:     u.Settings.Locale,
+		Reminders:  reminders,
+
+		CompressRecurrence: u.Settings.CompressRecurrence,
+		WeekMarkers:        u.Settings.WeekMarkers,
+		Templates:          u.Settings.Templates,
+	}
+}
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInvalidCredentials is returned when ITMO ID rejects the ISU or password.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrUserNotFound is returned when the user is not subscribed.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUpstreamUnavailable is returned when an ITMO service fails or can not be reached.
+	ErrUpstreamUnavailable = errors.New("upstream unavailable")
+	// ErrRateLimited is returned when an ITMO service throttles the requests.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrTokenExpired is returned when the user's ITMO ID tokens can no longer be used.
+	ErrTokenExpired = errors.New("token expired")
+)
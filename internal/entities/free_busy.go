@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"sort"
+	"time"
+)
+
+// BusyPeriod is a time span the user is busy in, without any details of what they are busy with.
+type BusyPeriod struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// FreeBusy is the availability of a user over a time range.
+type FreeBusy struct {
+	ISU  int64        `json:"isu"`
+	From time.Time    `json:"from"`
+	To   time.Time    `json:"to"`
+	Busy []BusyPeriod `json:"busy"`
+}
+
+// NewFreeBusy computes the busy periods of the schedule between from and to.
+// Every lesson is widened by padBefore and padAfter, overlapping and adjacent periods are merged
+// and the result is clipped to the range.
+func NewFreeBusy(isu int64, schedule []DaySchedule, from, to time.Time, padBefore, padAfter time.Duration) FreeBusy {
+	var periods []BusyPeriod
+	for _, day := range schedule {
+		for _, lesson := range day.Lessons {
+			start := lesson.Start.Add(-padBefore)
+			end := lesson.End.Add(padAfter)
+			if !end.After(from) || !start.Before(to) {
+				continue
+			}
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			periods = append(periods, BusyPeriod{Start: start.UTC(), End: end.UTC()})
+		}
+	}
+
+	return FreeBusy{
+		ISU:  isu,
+		From: from.UTC(),
+		To:   to.UTC(),
+		Busy: MergeBusy(periods),
+	}
+}
+
+// MergeBusy sorts the periods and merges overlapping and adjacent ones.
+func MergeBusy(periods []BusyPeriod) []BusyPeriod {
+	sorted := append([]BusyPeriod(nil), periods...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	busy := make([]BusyPeriod, 0, len(sorted))
+	for _, period := range sorted {
+		if last := len(busy) - 1; last >= 0 && !period.Start.After(busy[last].End) {
+			if period.End.After(busy[last].End) {
+				busy[last].End = period.End
+			}
+			continue
+		}
+		busy = append(busy, period)
+	}
+
+	return busy
+}
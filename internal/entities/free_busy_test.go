@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFreeBusy(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 10, 16, hour, minute, 0, 0, time.UTC)
+	}
+	lesson := func(start, end time.Time) Lesson {
+		return Lesson{Subject: "Secret", Start: start, End: end}
+	}
+
+	schedule := []DaySchedule{{
+		Date: at(0, 0),
+		Lessons: []Lesson{
+			lesson(at(11, 40), at(13, 10)),
+			lesson(at(8, 20), at(9, 50)),
+			lesson(at(10, 0), at(11, 30)),
+			lesson(at(18, 40), at(20, 10)),
+		},
+	}}
+
+	t.Run("merges with padding", func(t *testing.T) {
+		fb := NewFreeBusy(1, schedule, at(0, 0), at(23, 0), 5*time.Minute, 5*time.Minute)
+		assert.Equal(t, []BusyPeriod{
+			{Start: at(8, 15), End: at(13, 15)},
+			{Start: at(18, 35), End: at(20, 15)},
+		}, fb.Busy)
+	})
+
+	t.Run("clips to range", func(t *testing.T) {
+		fb := NewFreeBusy(1, schedule, at(9, 0), at(19, 0), 0, 0)
+		assert.Equal(t, []BusyPeriod{
+			{Start: at(9, 0), End: at(9, 50)},
+			{Start: at(10, 0), End: at(11, 30)},
+			{Start: at(11, 40), End: at(13, 10)},
+			{Start: at(18, 40), End: at(19, 0)},
+		}, fb.Busy)
+	})
+}
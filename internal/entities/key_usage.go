@@ -0,0 +1,33 @@
+package entities
+
+// KeyUsage tells how many rows of stored secrets, OAuth tokens and CalDAV passwords, are encrypted with each key.
+type KeyUsage struct {
+	// Current is the ID of the key new values are encrypted with, empty for the legacy key.
+	Current string `json:"current"`
+	// Rows are row counts by key ID.
+	Rows map[string]int64 `json:"rows"`
+}
+
+// Stale returns how many rows still use keys other than the current one.
+func (u *KeyUsage) Stale() int64 {
+	var stale int64
+	for id, count := range u.Rows {
+		if id != u.Current {
+			stale += count
+		}
+	}
+
+	return stale
+}
+
+// ReencryptPage is the outcome of re-encrypting one page of rows that do not use the current key.
+type ReencryptPage struct {
+	// Last is the ISU of the last row read, the next page starts after it.
+	Last int64 `json:"last"`
+	// Read counts the rows of the page.
+	Read int `json:"read"`
+	// Updated counts the rows moved to the current key.
+	Updated int `json:"updated"`
+	// Skipped counts the rows that could not be decrypted, e.g. because their key was removed.
+	Skipped int `json:"skipped"`
+}
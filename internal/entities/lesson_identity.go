@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LessonIdentity is the persistent calendar identity of a lesson slot.
+type LessonIdentity struct {
+	ISU int64 `json:"isu"`
+	// Slot identifies the lesson within the user's schedule, see DaySchedule.Slots.
+	Slot string `json:"slot"`
+	// UID is the iCalendar UID emitted for the slot.
+	UID string `json:"uid"`
+	// Sequence is the iCalendar SEQUENCE, bumped on every change of the lesson.
+	Sequence int `json:"sequence"`
+	// Fingerprint is the hash of the lesson fields last emitted for the slot.
+	Fingerprint string `json:"fingerprint"`
+	// CreatedAt is the time the slot was first emitted.
+	CreatedAt time.Time `json:"created_at"`
+	// ModifiedAt is the time the slot was last changed.
+	ModifiedAt time.Time `json:"modified_at"`
+	// CancelledAt is the time the lesson disappeared from the schedule, nil while it is present.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+}
+
+// NewLessonIdentity returns a fresh identity for the given slot.
+func NewLessonIdentity(isu int64, slot, fingerprint string, now time.Time) LessonIdentity {
+	return LessonIdentity{
+		ISU:         isu,
+		Slot:        slot,
+		UID:         LessonUID(isu, slot),
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ModifiedAt:  now,
+	}
+}
+
+// LessonUID returns the iCalendar UID of a lesson slot, it only depends on the user and the slot.
+func LessonUID(isu int64, slot string) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%d|%s", isu, slot)))
+
+	return hex.EncodeToString(h[:16]) + "@itmo-calendar"
+}
+
+// Slots returns slot keys for the day's lessons in the same order as Lessons.
+// A slot is the date, subject, type and group of a lesson, so a changed teacher,
+// room or time keeps the slot while a different lesson gets a new one.
+// Repeated slots within a day are told apart by their ordinal.
+func (d DaySchedule) Slots() []string {
+	slots := make([]string, 0, len(d.Lessons))
+	seen := make(map[string]int, len(d.Lessons))
+
+	for _, lesson := range d.Lessons {
+		key := strings.Join([]string{
+			d.Date.Format("2006-01-02"),
+			lesson.Subject,
+			lesson.Type,
+			lesson.Group,
+		}, "|")
+
+		seen[key]++
+		if n := seen[key]; n > 1 {
+			key = fmt.Sprintf("%s#%d", key, n)
+		}
+
+		slots = append(slots, key)
+	}
+
+	return slots
+}
+
+// Fingerprint returns a hash of all lesson fields rendered into the calendar.
+func (l Lesson) Fingerprint() string {
+	h := sha256.New()
+	for _, field := range []string{
+		l.Subject,
+		l.Type,
+		l.TeacherName,
+		l.Room,
+		l.Note,
+		l.Building,
+		l.Format,
+		l.Group,
+		l.ZoomURL,
+		l.Start.UTC().Format(time.RFC3339),
+		l.End.UTC().Format(time.RFC3339),
+	} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
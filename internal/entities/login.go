@@ -0,0 +1,102 @@
+package entities
+
+import "time"
+
+// ChallengeKind is a Keycloak page the user has to get through before a password login completes.
+type ChallengeKind string
+
+const (
+	// ChallengeOTP asks for a one-time code of the user's second factor.
+	ChallengeOTP ChallengeKind = "otp"
+	// ChallengeUpdatePassword asks to replace an expired password.
+	ChallengeUpdatePassword ChallengeKind = "update_password"
+	// ChallengeTerms asks to accept the terms of use.
+	ChallengeTerms ChallengeKind = "terms"
+)
+
+// PendingLogin is a login started at ITMO ID and not completed yet, identified by its state.
+// The code verifier never leaves the server. Password logins stopped by a challenge also
+// keep the Keycloak form to resume, browser logins have none.
+type PendingLogin struct {
+	State        string
+	CodeVerifier string
+	ISU          int64
+	Form         *LoginForm
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// LoginForm is a Keycloak page of a password login waiting for the user's input.
+type LoginForm struct {
+	Kind    ChallengeKind       `json:"kind"`
+	Action  string              `json:"action"`
+	Fields  map[string][]string `json:"fields"`
+	Cookies map[string]string   `json:"cookies"`
+	Referer string              `json:"referer"`
+	Message string              `json:"message,omitempty"`
+}
+
+// PasswordLogin is the progress of a password login: tokens once it completes, or the form
+// the user has to fill in first.
+type PasswordLogin struct {
+	ISU          int64
+	CodeVerifier string
+	Tokens       *UserTokens
+	Form         *LoginForm
+}
+
+// LoginChallenge is what the client has to answer to resume a password login.
+type LoginChallenge struct {
+	ID        string
+	Kind      ChallengeKind
+	Message   string
+	ExpiresAt time.Time
+}
+
+// ChallengeAnswer is the user's input to a login challenge, only the field of its kind is used.
+type ChallengeAnswer struct {
+	OTP         string
+	NewPassword string
+	AcceptTerms bool
+}
+
+// Answers reports whether the answer has the input the challenge kind asks for.
+func (a ChallengeAnswer) Answers(kind ChallengeKind) bool {
+	switch kind {
+	case ChallengeOTP:
+		return a.OTP != ""
+	case ChallengeUpdatePassword:
+		return a.NewPassword != ""
+	case ChallengeTerms:
+		return a.AcceptTerms
+	default:
+		return false
+	}
+}
+
+// LoginRequest is where to send the browser to log in at ITMO ID.
+type LoginRequest struct {
+	State string
+	URL   string
+}
+
+// Credentials prove the identity of a subscribing user: the state and code of a completed
+// browser login, the answer to a challenge of a password login, or the deprecated ISU and password pair.
+type Credentials struct {
+	State       string
+	Code        string
+	ChallengeID string
+	Answer      ChallengeAnswer
+	ISU         int64
+	Password    string
+}
+
+// Browser reports whether the credentials come from a browser login.
+func (c Credentials) Browser() bool {
+	return c.Code != ""
+}
+
+// Challenged reports whether the credentials resume a challenged password login.
+func (c Credentials) Challenged() bool {
+	return c.ChallengeID != ""
+}
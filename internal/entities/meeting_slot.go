@@ -0,0 +1,38 @@
+package entities
+
+import "time"
+
+// MeetingParticipant is a student whose schedule is taken into account when looking for meeting slots.
+// The share token is their consent, ISU is optional and checked against the token when set.
+type MeetingParticipant struct {
+	ISU   int64  `json:"isu,omitempty"`
+	Token string `json:"token"`
+}
+
+// MeetingQuery describes the meeting slots to look for.
+type MeetingQuery struct {
+	// From and To are the first and the last day of the search, inclusive.
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// MinDuration is the shortest slot worth returning.
+	MinDuration time.Duration `json:"min_duration"`
+	// DayStart and DayEnd bound the working hours of every day, as offsets from midnight.
+	DayStart time.Duration `json:"day_start"`
+	DayEnd   time.Duration `json:"day_end"`
+	// TimeZone of the working hours, the service default when empty.
+	TimeZone string `json:"time_zone,omitempty"`
+	// Travel is blocked before and after every lesson held in a building.
+	Travel time.Duration `json:"travel"`
+	Limit  int           `json:"limit"`
+}
+
+// MeetingSlot is a time span every participant is free in.
+type MeetingSlot struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Duration returns the length of the slot.
+func (s MeetingSlot) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
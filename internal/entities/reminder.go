@@ -0,0 +1,38 @@
+package entities
+
+import "strings"
+
+// ReminderRule configures alarms for lessons matching it.
+// Empty match fields match any lesson.
+type ReminderRule struct {
+	// LessonType is matched as a case-insensitive substring of Lesson.Type, e.g. "лек".
+	LessonType string `json:"lesson_type"`
+	// Format is matched as a case-insensitive substring of Lesson.Format.
+	Format string `json:"format"`
+	// MinutesBefore is how long before the lesson the alarm fires, nil disables alarms.
+	MinutesBefore *int `json:"minutes_before"`
+}
+
+// Matches reports whether the rule applies to the lesson.
+func (r ReminderRule) Matches(lesson Lesson) bool {
+	return containsFold(lesson.Type, r.LessonType) && containsFold(lesson.Format, r.Format)
+}
+
+// ReminderFor returns minutes before the lesson of the first matching rule.
+// Returns false if no rule matches or the matching rule disables alarms.
+func ReminderFor(rules []ReminderRule, lesson Lesson) (int, bool) {
+	for _, rule := range rules {
+		if rule.Matches(lesson) {
+			if rule.MinutesBefore == nil {
+				return 0, false
+			}
+			return *rule.MinutesBefore, true
+		}
+	}
+
+	return 0, false
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
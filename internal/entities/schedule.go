@@ -1,11 +1,16 @@
 package entities
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // DaySchedule represents a day's schedule.
 type DaySchedule struct {
 	Date    time.Time `json:"date"`
 	Lessons []Lesson  `json:"lessons"`
+	// Week is the academic week of the day, nil when no semester is configured for it.
+	Week *AcademicWeek `json:"week,omitempty"`
 }
 
 // Lesson represents a single lesson in the schedule.
@@ -22,3 +27,43 @@ type Lesson struct {
 	Start       time.Time `json:"time_start"`
 	End         time.Time `json:"time_end"`
 }
+
+// LessonKind is the normalised type of a lesson.
+type LessonKind string
+
+const (
+	LessonKindLecture  LessonKind = "lecture"
+	LessonKindPractice LessonKind = "practice"
+	LessonKindLab      LessonKind = "lab"
+	LessonKindOther    LessonKind = "other"
+)
+
+// KindOf classifies a lesson type as named by the ITMO schedule, e.g. "Лекции" or "Лабораторные работы".
+func KindOf(lessonType string) LessonKind {
+	lower := strings.ToLower(strings.TrimSpace(lessonType))
+	switch {
+	case strings.HasPrefix(lower, "лек"):
+		return LessonKindLecture
+	case strings.HasPrefix(lower, "прак"):
+		return LessonKindPractice
+	case strings.HasPrefix(lower, "лаб"):
+		return LessonKindLab
+	default:
+		return LessonKindOther
+	}
+}
+
+// Kind returns the normalised type of the lesson.
+func (l Lesson) Kind() LessonKind {
+	return KindOf(l.Type)
+}
+
+// Remote reports whether the lesson is held online, so nobody has to travel to a building for it.
+func (l Lesson) Remote() bool {
+	if strings.TrimSpace(l.Building) == "" {
+		return true
+	}
+
+	format := strings.ToLower(l.Format)
+	return strings.Contains(format, "дистан") || strings.Contains(format, "онлайн") || strings.Contains(format, "online")
+}
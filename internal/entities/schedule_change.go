@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+// ChangeType tells how a lesson changed between two calendar generations.
+type ChangeType string
+
+const (
+	ChangeTypeAdded   ChangeType = "added"
+	ChangeTypeChanged ChangeType = "changed"
+	ChangeTypeRemoved ChangeType = "removed"
+)
+
+// ScheduleChange is an entry of a user's schedule change log.
+type ScheduleChange struct {
+	// ID orders changes of all users, sync tokens point at it.
+	ID  int64 `json:"id"`
+	ISU int64 `json:"isu"`
+	// UID is the iCalendar UID of the lesson, see LessonUID.
+	UID  string     `json:"uid"`
+	Type ChangeType `json:"type"`
+	// Date is the day of the lesson.
+	Date time.Time `json:"date"`
+	// Lesson is the lesson after the change, nil for a removed one.
+	Lesson    *Lesson   `json:"lesson,omitempty"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// ScheduleChanges are the changes since a sync token together with the token to continue from.
+type ScheduleChanges struct {
+	Changes   []ScheduleChange `json:"changes"`
+	SyncToken string           `json:"sync_token"`
+}
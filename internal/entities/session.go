@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a login of a user to the API. Access tokens reference it,
+// so revoking the session invalidates all tokens issued for it.
+type Session struct {
+	ID  uuid.UUID `json:"id"`
+	ISU int64     `json:"isu"`
+	// RefreshID is the ID of the only refresh token of the session that is still valid.
+	RefreshID uuid.UUID `json:"refresh_id"`
+	// CreatedAt is the login timestamp.
+	CreatedAt time.Time `json:"created_at"`
+	// ExpiresAt is when the current refresh token expires.
+	ExpiresAt time.Time `json:"expires_at"`
+	// RevokedAt is set once the user logs out or a refresh token is reused.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// Active reports whether the session can still be used at the moment.
+func (s *Session) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// SessionTokens are the tokens handed out to the client of a session.
+type SessionTokens struct {
+	// AccessToken authorizes API requests.
+	AccessToken string `json:"access_token"`
+	// AccessTokenExpiresAt is the expiration time for the access token.
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	// RefreshToken is exchanged for new tokens once the access token expires.
+	RefreshToken string `json:"refresh_token"`
+	// RefreshTokenExpiresAt is the expiration time for the refresh token.
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
@@ -0,0 +1,11 @@
+package entities
+
+// ShareScope is what a share token grants access to.
+type ShareScope string
+
+const (
+	// ShareScopeFreeBusy grants access to the user's availability without lesson details.
+	ShareScopeFreeBusy ShareScope = "freebusy"
+	// ShareScopeFeed grants access to the user's full iCal feed.
+	ShareScopeFeed ShareScope = "feed"
+)
@@ -0,0 +1,9 @@
+package entities
+
+// Subscription is the result of subscribing to the schedule.
+type Subscription struct {
+	// FeedToken is the secret of the iCal feed URL, only set on the first subscription.
+	FeedToken string `json:"feed_token,omitempty"`
+	// Session holds the tokens to call the API on behalf of the user.
+	Session *SessionTokens `json:"session"`
+}
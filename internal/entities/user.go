@@ -10,6 +10,16 @@ type User struct {
 	ISU int64 `json:"isu"`
 	// CalDavURL is the user's CalDav URL.
 	CalDavURL string `json:"caldav_url"`
+	// Settings are the user's calendar preferences.
+	Settings UserSettings `json:"settings"`
+	// Status tells whether the user's schedule is synced.
+	Status UserStatus `json:"status"`
+	// StatusChangedAt is when the status last changed, nil if it never did.
+	StatusChangedAt *time.Time `json:"status_changed_at"`
+	// LastSyncedAt is the last time the schedule was synced.
+	LastSyncedAt *time.Time `json:"last_synced_at"`
+	// LastFailedAt is the last time syncing the schedule failed.
+	LastFailedAt *time.Time `json:"last_failed_at"`
 	// CreatedAt is the creation timestamp.
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt is the last update timestamp.
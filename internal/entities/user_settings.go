@@ -0,0 +1,23 @@
+package entities
+
+// UserSettings holds user preferences for generated calendars.
+type UserSettings struct {
+	// TimeZone is the IANA zone calendars are rendered in, empty for the default.
+	TimeZone string `json:"time_zone"`
+	// Locale is the language of generated calendars, empty for the default.
+	Locale string `json:"locale"`
+	// CompressRecurrence folds weekly lessons into recurring events.
+	CompressRecurrence bool `json:"compress_recurrence"`
+	// WeekMarkers adds all-day events naming academic weeks.
+	WeekMarkers bool `json:"week_markers"`
+	// Templates customise how lessons are rendered into events.
+	Templates EventTemplates `json:"templates"`
+}
+
+// EventTemplates are text/template strings evaluated against a Lesson.
+// An empty template keeps the default rendering of the property.
+type EventTemplates struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+	Location    string `json:"location"`
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Both errors are ErrTokenExpired.
+var (
+	// ErrRefreshTokenRejected is returned when ITMO ID no longer accepts a refresh token,
+	// e.g. because the user logged out everywhere or changed their password.
+	ErrRefreshTokenRejected = fmt.Errorf("refresh token rejected: %w", ErrTokenExpired)
+	// ErrReauthRequired is returned when the user's ITMO ID tokens are missing or expired.
+	ErrReauthRequired = fmt.Errorf("re-authentication required: %w", ErrTokenExpired)
+)
+
+// UserStatus tells whether the user's schedule can be synced.
+type UserStatus string
+
+const (
+	// UserStatusActive users are synced by the cron.
+	UserStatusActive UserStatus = "active"
+	// UserStatusNeedsReauth users let their ITMO ID tokens expire and have to subscribe again.
+	UserStatusNeedsReauth UserStatus = "needs_reauth"
+	// UserStatusRevoked users had their ITMO ID tokens rejected and have to subscribe again.
+	UserStatusRevoked UserStatus = "revoked"
+)
+
+// Active reports whether the user is synced.
+func (s UserStatus) Active() bool {
+	return s == "" || s == UserStatusActive
+}
+
+// ReauthStatus returns the status of a user whose sync failed with the error,
+// false if the error does not require them to subscribe again.
+func ReauthStatus(err error) (UserStatus, bool) {
+	switch {
+	case errors.Is(err, ErrRefreshTokenRejected):
+		return UserStatusRevoked, true
+	case errors.Is(err, ErrReauthRequired):
+		return UserStatusNeedsReauth, true
+	default:
+		return "", false
+	}
+}
+
+// SyncState is how syncing the user's schedule goes.
+type SyncState struct {
+	Status          UserStatus
+	StatusChangedAt *time.Time
+	LastSyncedAt    *time.Time
+	LastFailedAt    *time.Time
+	// TokensExpireAt is when the ITMO ID refresh token expires, nil without tokens.
+	TokensExpireAt *time.Time
+}
@@ -0,0 +1,15 @@
+package caldav
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Collections interface {
+	Execute(ctx context.Context, isu int64) (*entities.CalendarCollection, error)
+}
+
+type FeedCollections interface {
+	Execute(ctx context.Context, token string) (*entities.CalendarCollection, error)
+}
@@ -0,0 +1,209 @@
+package caldav
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/services/shares"
+)
+
+// _objectContentType is the media type of calendar object resources.
+const _objectContentType = "text/calendar; charset=utf-8; component=vevent"
+
+// Handler serves users' calendars as read-only CalDAV collections under their secret feed token:
+//
+//	/caldav/{token}/                               principal
+//	/caldav/{token}/calendars/                     calendar home
+//	/caldav/{token}/calendars/schedule/            calendar
+//	/caldav/{token}/calendars/schedule/{uid}.ics   calendar object
+//
+// The deprecated handler of NewLegacyHandler serves the same tree by bare ISU, /caldav/{isu}/.
+type Handler struct {
+	// key is the route pattern of the path segment that identifies the user.
+	key    string
+	lookup func(ctx context.Context, key string) (*entities.CalendarCollection, error)
+	logger *zap.Logger
+}
+
+// NewHandler serves collections by feed token, the token is the only credential CalDAV clients need.
+func NewHandler(feeds FeedCollections, logger *zap.Logger) *Handler {
+	return &Handler{
+		key: "{key:[A-Za-z0-9_-]{20,}}",
+		lookup: func(ctx context.Context, token string) (*entities.CalendarCollection, error) {
+			collection, err := feeds.Execute(ctx, token)
+			if errors.Is(err, shares.ErrInvalidToken) {
+				// Unknown and revoked tokens look the same as calendars that do not exist.
+				return nil, nil
+			}
+			return collection, err
+		},
+		logger: logger.With(zap.String("component", "caldav_handler")),
+	}
+}
+
+// NewLegacyHandler serves collections by bare ISU. Anyone can read any calendar this way,
+// it is only mounted while HTTPServer.LegacyISUPaths is enabled.
+func NewLegacyHandler(collections Collections, logger *zap.Logger) *Handler {
+	return &Handler{
+		key: "{key:[0-9]+}",
+		lookup: func(ctx context.Context, key string) (*entities.CalendarCollection, error) {
+			isu, err := strconv.ParseInt(key, 10, 64)
+			if err != nil {
+				return nil, nil
+			}
+			return collections.Execute(ctx, isu)
+		},
+		logger: logger.With(zap.String("component", "caldav_legacy_handler")),
+	}
+}
+
+// AddRoutes registers CalDAV routes, collections are reachable with and without the trailing slash.
+func (h *Handler) AddRoutes(router *mux.Router) {
+	prefix := "/caldav/" + h.key
+
+	for path, kind := range map[string]resourceKind{
+		prefix:                                 kindPrincipal,
+		prefix + "/calendars":                  kindHome,
+		prefix + "/calendars/" + _calendarName: kindCalendar,
+	} {
+		router.Handle(path, h.serve(kind))
+		router.Handle(path+"/", h.serve(kind))
+	}
+	router.Handle(prefix+"/calendars/"+_calendarName+"/{object}", h.serve(kindObject))
+}
+
+func (h *Handler) serve(kind resourceKind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			h.options(w, kind)
+			return
+		}
+
+		key := mux.Vars(r)["key"]
+		collection, err := h.lookup(r.Context(), key)
+		if err != nil {
+			h.internalError(w, r, err)
+			return
+		}
+		if collection == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		var object *entities.CalendarObject
+		if kind == kindObject {
+			object = collection.Object(strings.TrimSuffix(mux.Vars(r)["object"], ".ics"))
+			if object == nil {
+				http.NotFound(w, r)
+				return
+			}
+		}
+
+		root := principalHref(key)
+
+		switch {
+		case r.Method == "PROPFIND":
+			h.propfind(w, r, kind, root, collection, object)
+		case r.Method == "REPORT" && kind == kindCalendar:
+			h.report(w, r, root, collection)
+		case (r.Method == http.MethodGet || r.Method == http.MethodHead) && kind == kindObject:
+			h.getObject(w, r, object)
+		default:
+			w.Header().Set("Allow", allowed(kind))
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+func (h *Handler) options(w http.ResponseWriter, kind resourceKind) {
+	w.Header().Set("DAV", "1, 3, calendar-access")
+	w.Header().Set("Allow", allowed(kind))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) propfind(w http.ResponseWriter, r *http.Request, kind resourceKind, root string, collection *entities.CalendarCollection, object *entities.CalendarObject) {
+	var req propfindRequest
+	err := readXML(r, &req)
+	if err != nil {
+		h.badRequest(w, r, err)
+		return
+	}
+
+	requested, namesOnly := req.requested(), req.PropName != nil
+
+	ms := newMultistatus()
+	ms.add(href(kind, root, object), properties(kind, root, collection, object), requested, namesOnly)
+
+	// Depth infinity is answered as Depth 1, the tree is shallow anyway.
+	if r.Header.Get("Depth") != "0" {
+		switch kind {
+		case kindPrincipal:
+			ms.add(homeHref(root), properties(kindHome, root, collection, nil), requested, namesOnly)
+		case kindHome:
+			ms.add(calendarHref(root), properties(kindCalendar, root, collection, nil), requested, namesOnly)
+		case kindCalendar:
+			for i := range collection.Objects {
+				obj := &collection.Objects[i]
+				ms.add(objectHref(root, obj.UID), properties(kindObject, root, collection, obj), requested, namesOnly)
+			}
+		}
+	}
+
+	ms.write(w)
+}
+
+func (h *Handler) getObject(w http.ResponseWriter, r *http.Request, object *entities.CalendarObject) {
+	w.Header().Set("Content-Type", _objectContentType)
+	w.Header().Set("ETag", object.ETag)
+
+	// ServeContent answers HEAD and If-None-Match against the ETag.
+	http.ServeContent(w, r, "", time.Time{}, strings.NewReader(object.Data))
+}
+
+func (h *Handler) internalError(w http.ResponseWriter, r *http.Request, err error) {
+	h.logger.Error("CalDAV request failed",
+		zap.String("method", r.Method),
+		zap.String("path", logPath(r)),
+		zap.Error(err),
+	)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// badRequest answers a request body that can not be read, the decoder error is only logged.
+func (h *Handler) badRequest(w http.ResponseWriter, r *http.Request, err error) {
+	h.logger.Warn("invalid CalDAV request",
+		zap.String("method", r.Method),
+		zap.String("path", logPath(r)),
+		zap.Error(err),
+	)
+	http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+}
+
+// logPath returns the request path without the key, it is the user's feed token and must not end up in logs.
+func logPath(r *http.Request) string {
+	path := r.URL.Path
+	if key := mux.Vars(r)["key"]; key != "" {
+		path = strings.Replace(path, "/caldav/"+key, "/caldav/{token}", 1)
+	}
+
+	return path
+}
+
+func allowed(kind resourceKind) string {
+	switch kind {
+	case kindCalendar:
+		return "OPTIONS, PROPFIND, REPORT"
+	case kindObject:
+		return "OPTIONS, GET, HEAD, PROPFIND"
+	default:
+		return "OPTIONS, PROPFIND"
+	}
+}
@@ -0,0 +1,178 @@
+package caldav
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/services/shares"
+)
+
+const _testToken = "Zm9yLXRlc3RzLW9ubHktMDEyMzQ1Njc4OWFiY2RlZg"
+
+type collectionsFunc func(ctx context.Context, isu int64) (*entities.CalendarCollection, error)
+
+func (f collectionsFunc) Execute(ctx context.Context, isu int64) (*entities.CalendarCollection, error) {
+	return f(ctx, isu)
+}
+
+type feedCollectionsFunc func(ctx context.Context, token string) (*entities.CalendarCollection, error)
+
+func (f feedCollectionsFunc) Execute(ctx context.Context, token string) (*entities.CalendarCollection, error) {
+	return f(ctx, token)
+}
+
+func testRouter(t *testing.T, legacy bool) *mux.Router {
+	t.Helper()
+
+	collection := &entities.CalendarCollection{
+		ISU:  123456,
+		Name: "ITMO schedule",
+		CTag: "ctag",
+		Objects: []entities.CalendarObject{
+			{
+				UID:   "a@itmo-calendar",
+				Data:  "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n",
+				ETag:  `"a"`,
+				Start: time.Date(2024, 9, 2, 8, 20, 0, 0, time.UTC),
+				End:   time.Date(2024, 9, 2, 9, 50, 0, 0, time.UTC),
+			},
+			{
+				UID:   "b@itmo-calendar",
+				Data:  "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n",
+				ETag:  `"b"`,
+				Start: time.Date(2024, 9, 9, 8, 20, 0, 0, time.UTC),
+				End:   time.Date(2024, 9, 9, 9, 50, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	router := mux.NewRouter()
+	NewHandler(feedCollectionsFunc(func(_ context.Context, token string) (*entities.CalendarCollection, error) {
+		if token != _testToken {
+			return nil, shares.ErrInvalidToken
+		}
+		return collection, nil
+	}), zap.NewNop()).AddRoutes(router)
+	if legacy {
+		NewLegacyHandler(collectionsFunc(func(_ context.Context, isu int64) (*entities.CalendarCollection, error) {
+			if isu != collection.ISU {
+				return nil, nil
+			}
+			return collection, nil
+		}), zap.NewNop()).AddRoutes(router)
+	}
+
+	return router
+}
+
+func do(router http.Handler, method, path, depth, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if depth != "" {
+		req.Header.Set("Depth", depth)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	router := testRouter(t, false)
+
+	t.Run("principal points to calendar home", func(t *testing.T) {
+		rec := do(router, "PROPFIND", "/caldav/"+_testToken, "0", `<?xml version="1.0"?>
+<d:propfind xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:current-user-principal/><c:calendar-home-set/><d:getlastmodified/></d:prop>
+</d:propfind>`)
+		require.Equal(t, http.StatusMultiStatus, rec.Code)
+		assert.Contains(t, rec.Body.String(), `<C:calendar-home-set><D:href>/caldav/`+_testToken+`/calendars/</D:href></C:calendar-home-set>`)
+		assert.Contains(t, rec.Body.String(), `<D:getlastmodified></D:getlastmodified></D:prop><D:status>HTTP/1.1 404 Not Found</D:status>`)
+	})
+
+	t.Run("calendar lists objects with etags at depth 1", func(t *testing.T) {
+		rec := do(router, "PROPFIND", "/caldav/"+_testToken+"/calendars/schedule/", "1", "")
+		require.Equal(t, http.StatusMultiStatus, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, `<C:calendar/>`)
+		assert.Contains(t, body, `<D:href>/caldav/`+_testToken+`/calendars/schedule/a@itmo-calendar.ics</D:href>`)
+		assert.Contains(t, body, `<D:getetag>&#34;b&#34;</D:getetag>`)
+		assert.NotContains(t, body, `<C:calendar-data>`)
+	})
+
+	t.Run("calendar-query filters by time range", func(t *testing.T) {
+		rec := do(router, "REPORT", "/caldav/"+_testToken+"/calendars/schedule/", "1", `<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:getetag/><c:calendar-data/></d:prop>
+  <c:filter><c:comp-filter name="VCALENDAR"><c:comp-filter name="VEVENT">
+    <c:time-range start="20240905T000000Z" end="20240912T000000Z"/>
+  </c:comp-filter></c:comp-filter></c:filter>
+</c:calendar-query>`)
+		require.Equal(t, http.StatusMultiStatus, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "a@itmo-calendar")
+		assert.Contains(t, rec.Body.String(), "b@itmo-calendar")
+		assert.Contains(t, rec.Body.String(), "<C:calendar-data>BEGIN:VCALENDAR")
+	})
+
+	t.Run("calendar-multiget returns requested objects", func(t *testing.T) {
+		rec := do(router, "REPORT", "/caldav/"+_testToken+"/calendars/schedule/", "", `<c:calendar-multiget xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:getetag/></d:prop>
+  <d:href>/caldav/`+_testToken+`/calendars/schedule/a%40itmo-calendar.ics</d:href>
+  <d:href>/caldav/`+_testToken+`/calendars/schedule/missing.ics</d:href>
+</c:calendar-multiget>`)
+		require.Equal(t, http.StatusMultiStatus, rec.Code)
+		assert.Contains(t, rec.Body.String(), `<D:getetag>&#34;a&#34;</D:getetag>`)
+		assert.Contains(t, rec.Body.String(), `missing.ics</D:href><D:status>HTTP/1.1 404 Not Found</D:status>`)
+	})
+
+	t.Run("object is served with its etag", func(t *testing.T) {
+		rec := do(router, http.MethodGet, "/caldav/"+_testToken+"/calendars/schedule/a@itmo-calendar.ics", "", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"a"`, rec.Header().Get("ETag"))
+		assert.Equal(t, "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", rec.Body.String())
+
+		req := httptest.NewRequest(http.MethodGet, "/caldav/"+_testToken+"/calendars/schedule/a@itmo-calendar.ics", nil)
+		req.Header.Set("If-None-Match", `"a"`)
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+	})
+
+	t.Run("malformed body is rejected without decoder details", func(t *testing.T) {
+		rec := do(router, "REPORT", "/caldav/"+_testToken+"/calendars/schedule/", "", `<c:calendar-query xmlns:c="urn:ietf:params:xml:ns:caldav">`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "Bad Request\n", rec.Body.String())
+	})
+
+	t.Run("unknown token is not found", func(t *testing.T) {
+		rec := do(router, "PROPFIND", "/caldav/Zm9yLXRlc3RzLW9ubHktdW5rbm93bg/", "0", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("bare ISU is not served", func(t *testing.T) {
+		rec := do(router, "PROPFIND", "/caldav/123456/", "0", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestLegacyHandler(t *testing.T) {
+	router := testRouter(t, true)
+
+	rec := do(router, "PROPFIND", "/caldav/123456/calendars/schedule/", "1", "")
+	require.Equal(t, http.StatusMultiStatus, rec.Code)
+	assert.Contains(t, rec.Body.String(), `<D:href>/caldav/123456/calendars/schedule/a@itmo-calendar.ics</D:href>`)
+
+	rec = do(router, "PROPFIND", "/caldav/1/", "0", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = do(router, "PROPFIND", "/caldav/"+_testToken+"/", "0", "")
+	assert.Equal(t, http.StatusMultiStatus, rec.Code)
+}
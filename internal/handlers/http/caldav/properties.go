@@ -0,0 +1,100 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strconv"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// _calendarName is the path segment of the only calendar in a user's calendar home.
+const _calendarName = "schedule"
+
+// resourceKind tells which resource of the CalDAV tree a request addresses.
+type resourceKind int
+
+const (
+	kindPrincipal resourceKind = iota
+	kindHome
+	kindCalendar
+	kindObject
+)
+
+// principalHref returns the path of the principal identified by the key, the root of the user's tree.
+func principalHref(key string) string {
+	return "/caldav/" + key + "/"
+}
+
+func homeHref(root string) string {
+	return root + "calendars/"
+}
+
+func calendarHref(root string) string {
+	return homeHref(root) + _calendarName + "/"
+}
+
+func objectHref(root, uid string) string {
+	return calendarHref(root) + url.PathEscape(uid) + ".ics"
+}
+
+// href returns the canonical path of a resource.
+func href(kind resourceKind, root string, object *entities.CalendarObject) string {
+	switch kind {
+	case kindHome:
+		return homeHref(root)
+	case kindCalendar:
+		return calendarHref(root)
+	case kindObject:
+		return objectHref(root, object.UID)
+	default:
+		return root
+	}
+}
+
+// properties returns the WebDAV properties of a resource.
+func properties(kind resourceKind, root string, collection *entities.CalendarCollection, object *entities.CalendarObject) []property {
+	principal := hrefXML(root)
+
+	switch kind {
+	case kindPrincipal:
+		return []property{
+			{name: xml.Name{Space: nsDAV, Local: "resourcetype"}, value: `<D:collection/><D:principal/>`},
+			{name: xml.Name{Space: nsDAV, Local: "displayname"}, value: escape(collection.Name)},
+			{name: xml.Name{Space: nsDAV, Local: "current-user-principal"}, value: principal},
+			{name: xml.Name{Space: nsDAV, Local: "principal-URL"}, value: principal},
+			{name: xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}, value: hrefXML(homeHref(root))},
+		}
+	case kindHome:
+		return []property{
+			{name: xml.Name{Space: nsDAV, Local: "resourcetype"}, value: `<D:collection/>`},
+			{name: xml.Name{Space: nsDAV, Local: "current-user-principal"}, value: principal},
+			{name: xml.Name{Space: nsDAV, Local: "owner"}, value: principal},
+		}
+	case kindCalendar:
+		return []property{
+			{name: xml.Name{Space: nsDAV, Local: "resourcetype"}, value: `<D:collection/><C:calendar/>`},
+			{name: xml.Name{Space: nsDAV, Local: "displayname"}, value: escape(collection.Name)},
+			{name: xml.Name{Space: nsDAV, Local: "current-user-principal"}, value: principal},
+			{name: xml.Name{Space: nsDAV, Local: "owner"}, value: principal},
+			{name: xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}, value: `<D:privilege><D:read/></D:privilege>`},
+			{name: xml.Name{Space: nsDAV, Local: "supported-report-set"}, value: `<D:supported-report><D:report><C:calendar-query/></D:report></D:supported-report>` +
+				`<D:supported-report><D:report><C:calendar-multiget/></D:report></D:supported-report>`},
+			{name: xml.Name{Space: nsDAV, Local: "getetag"}, value: escape(`"` + collection.CTag + `"`)},
+			{name: xml.Name{Space: nsCalServer, Local: "getctag"}, value: escape(collection.CTag)},
+			{name: xml.Name{Space: nsCalDAV, Local: "calendar-description"}, value: escape(collection.Description)},
+			{name: xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}, value: `<C:comp name="VEVENT"/>`},
+			{name: xml.Name{Space: nsCalDAV, Local: "supported-calendar-data"}, value: `<C:calendar-data content-type="text/calendar" version="2.0"/>`},
+		}
+	case kindObject:
+		return []property{
+			{name: xml.Name{Space: nsDAV, Local: "resourcetype"}},
+			{name: xml.Name{Space: nsDAV, Local: "getetag"}, value: escape(object.ETag)},
+			{name: xml.Name{Space: nsDAV, Local: "getcontenttype"}, value: escape(_objectContentType)},
+			{name: xml.Name{Space: nsDAV, Local: "getcontentlength"}, value: strconv.Itoa(len(object.Data))},
+			{name: xml.Name{Space: nsCalDAV, Local: "calendar-data"}, value: escape(object.Data), hidden: true},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+const _timeRangeFormat = "20060102T150405Z"
+
+type reportRequest struct {
+	XMLName xml.Name
+	propRequest
+	Hrefs  []string `xml:"DAV: href"`
+	Filter *filter  `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+type filter struct {
+	CompFilter compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type compFilter struct {
+	Name         string       `xml:"name,attr"`
+	IsNotDefined *struct{}    `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+	TimeRange    *timeRange   `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	CompFilters  []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type timeRange struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+func (h *Handler) report(w http.ResponseWriter, r *http.Request, root string, collection *entities.CalendarCollection) {
+	var req reportRequest
+	err := readXML(r, &req)
+	if err != nil {
+		h.badRequest(w, r, err)
+		return
+	}
+
+	requested, namesOnly := req.requested(), req.PropName != nil
+
+	ms := newMultistatus()
+
+	switch req.XMLName {
+	case xml.Name{Space: nsCalDAV, Local: "calendar-query"}:
+		for i := range collection.Objects {
+			object := &collection.Objects[i]
+			if req.Filter.matches(*object) {
+				ms.add(objectHref(root, object.UID), properties(kindObject, root, collection, object), requested, namesOnly)
+			}
+		}
+	case xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}:
+		for _, ref := range req.Hrefs {
+			object := objectByHref(root, collection, ref)
+			if object == nil {
+				ms.addStatus(ref, http.StatusNotFound)
+				continue
+			}
+			ms.add(ref, properties(kindObject, root, collection, object), requested, namesOnly)
+		}
+	default:
+		http.Error(w, "unsupported report", http.StatusForbidden)
+		return
+	}
+
+	ms.write(w)
+}
+
+// objectByHref resolves an absolute or relative href to an object of the collection.
+func objectByHref(root string, collection *entities.CalendarCollection, ref string) *entities.CalendarObject {
+	u, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return nil
+	}
+
+	name, ok := strings.CutPrefix(u.Path, calendarHref(root))
+	if !ok || !strings.HasSuffix(name, ".ics") {
+		return nil
+	}
+
+	return collection.Object(strings.TrimSuffix(name, ".ics"))
+}
+
+// matches reports whether an object satisfies a calendar-query filter.
+// Only VEVENT component filters and their time ranges are evaluated, a missing filter matches everything.
+func (f *filter) matches(object entities.CalendarObject) bool {
+	if f == nil {
+		return true
+	}
+	if !strings.EqualFold(f.CompFilter.Name, "VCALENDAR") {
+		return false
+	}
+
+	for _, cf := range f.CompFilter.CompFilters {
+		if !strings.EqualFold(cf.Name, "VEVENT") {
+			// The collection holds events only.
+			if cf.IsNotDefined == nil {
+				return false
+			}
+			continue
+		}
+		if cf.IsNotDefined != nil {
+			return false
+		}
+		if cf.TimeRange != nil && !cf.TimeRange.overlaps(object.Start, object.End) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// overlaps reports whether the range intersects [start, end), an open bound is unlimited.
+func (t *timeRange) overlaps(start, end time.Time) bool {
+	if from, err := time.Parse(_timeRangeFormat, t.Start); err == nil && !end.After(from) && !start.Equal(from) {
+		return false
+	}
+	if to, err := time.Parse(_timeRangeFormat, t.End); err == nil && !start.Before(to) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,214 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	nsDAV       = "DAV:"
+	nsCalDAV    = "urn:ietf:params:xml:ns:caldav"
+	nsCalServer = "http://calendarserver.org/ns/"
+
+	// _maxRequestBody limits the size of PROPFIND and REPORT bodies.
+	_maxRequestBody = 1 << 20
+)
+
+// _prefixes are the namespace prefixes declared on every multistatus response.
+var _prefixes = map[string]string{
+	nsDAV:       "D",
+	nsCalDAV:    "C",
+	nsCalServer: "CS",
+}
+
+// property is a WebDAV property with its value as inner XML.
+type property struct {
+	name  xml.Name
+	value string
+	// hidden properties are only returned when requested by name, not for allprop.
+	hidden bool
+}
+
+// propNames collects the names of the properties listed in a DAV:prop element.
+type propNames []xml.Name
+
+func (p *propNames) UnmarshalXML(d *xml.Decoder, _ xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			*p = append(*p, t.Name)
+			err = d.Skip()
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// propRequest is the property selection shared by PROPFIND and REPORT bodies.
+type propRequest struct {
+	AllProp  *struct{} `xml:"DAV: allprop"`
+	PropName *struct{} `xml:"DAV: propname"`
+	Prop     propNames `xml:"DAV: prop"`
+}
+
+// requested returns the requested property names, nil for all properties.
+func (r propRequest) requested() []xml.Name {
+	if r.AllProp != nil || r.PropName != nil {
+		return nil
+	}
+
+	return r.Prop
+}
+
+type propfindRequest struct {
+	XMLName xml.Name `xml:"DAV: propfind"`
+	propRequest
+}
+
+// readXML decodes a request body into v, an empty body leaves v untouched.
+func readXML(r *http.Request, v any) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, _maxRequestBody))
+	if err != nil {
+		return errors.Wrap(err, "read body")
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	err = xml.Unmarshal(body, v)
+	if err != nil {
+		return errors.Wrap(err, "decode body")
+	}
+
+	return nil
+}
+
+// multistatus builds a 207 Multi-Status response.
+type multistatus struct {
+	buf strings.Builder
+}
+
+func newMultistatus() *multistatus {
+	m := &multistatus{}
+	m.buf.WriteString(xml.Header)
+	m.buf.WriteString(`<D:multistatus`)
+	for _, ns := range []string{nsDAV, nsCalDAV, nsCalServer} {
+		fmt.Fprintf(&m.buf, ` xmlns:%s="%s"`, _prefixes[ns], ns)
+	}
+	m.buf.WriteString(`>`)
+
+	return m
+}
+
+// add writes the response for a resource. With requested set, properties the resource lacks
+// are reported with 404, otherwise all its visible properties are written.
+func (m *multistatus) add(href string, props []property, requested []xml.Name, namesOnly bool) {
+	var found, missing []property
+
+	if requested == nil {
+		for _, prop := range props {
+			if !prop.hidden {
+				found = append(found, prop)
+			}
+		}
+	} else {
+		for _, name := range requested {
+			prop, ok := lookup(props, name)
+			if ok {
+				found = append(found, prop)
+			} else {
+				missing = append(missing, property{name: name})
+			}
+		}
+	}
+
+	m.buf.WriteString(`<D:response>`)
+	m.buf.WriteString(hrefXML(href))
+	if len(found) > 0 || len(missing) == 0 {
+		m.propstat(found, http.StatusOK, namesOnly)
+	}
+	if len(missing) > 0 {
+		m.propstat(missing, http.StatusNotFound, true)
+	}
+	m.buf.WriteString(`</D:response>`)
+}
+
+// addStatus writes a response carrying only a status, e.g. for an unknown href.
+func (m *multistatus) addStatus(href string, status int) {
+	m.buf.WriteString(`<D:response>`)
+	m.buf.WriteString(hrefXML(href))
+	m.buf.WriteString(statusXML(status))
+	m.buf.WriteString(`</D:response>`)
+}
+
+func (m *multistatus) propstat(props []property, status int, namesOnly bool) {
+	m.buf.WriteString(`<D:propstat><D:prop>`)
+	for _, prop := range props {
+		open, closing := element(prop.name)
+		m.buf.WriteString(open)
+		if !namesOnly {
+			m.buf.WriteString(prop.value)
+		}
+		m.buf.WriteString(closing)
+	}
+	m.buf.WriteString(`</D:prop>`)
+	m.buf.WriteString(statusXML(status))
+	m.buf.WriteString(`</D:propstat>`)
+}
+
+func (m *multistatus) write(w http.ResponseWriter) {
+	m.buf.WriteString(`</D:multistatus>`)
+
+	w.Header().Set("Content-Type", `application/xml; charset=utf-8`)
+	w.WriteHeader(http.StatusMultiStatus)
+	_, _ = io.WriteString(w, m.buf.String())
+}
+
+func lookup(props []property, name xml.Name) (property, bool) {
+	for _, prop := range props {
+		if prop.name == name {
+			return prop, true
+		}
+	}
+
+	return property{}, false
+}
+
+// element returns the opening and closing tags of an element, declaring its namespace
+// when it is not one of the response prefixes.
+func element(name xml.Name) (string, string) {
+	if prefix, ok := _prefixes[name.Space]; ok {
+		return "<" + prefix + ":" + name.Local + ">", "</" + prefix + ":" + name.Local + ">"
+	}
+
+	return `<X:` + name.Local + ` xmlns:X="` + escape(name.Space) + `">`, `</X:` + name.Local + `>`
+}
+
+func hrefXML(href string) string {
+	return `<D:href>` + escape(href) + `</D:href>`
+}
+
+func statusXML(status int) string {
+	return fmt.Sprintf(`<D:status>HTTP/1.1 %d %s</D:status>`, status, http.StatusText(status))
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
@@ -0,0 +1,11 @@
+package feeds
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Feeds interface {
+	Execute(ctx context.Context, token string) (*entities.CalDav, error)
+}
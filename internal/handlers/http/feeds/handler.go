@@ -0,0 +1,61 @@
+package feeds
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/services/shares"
+)
+
+// Handler serves users' iCal feeds at secret URLs:
+//
+//	/feeds/{token}.ics
+type Handler struct {
+	feeds        Feeds
+	cacheControl string
+	logger       *zap.Logger
+}
+
+func NewHandler(feeds Feeds, cacheControl string, logger *zap.Logger) *Handler {
+	return &Handler{
+		feeds:        feeds,
+		cacheControl: cacheControl,
+		logger:       logger.With(zap.String("component", "feeds_handler")),
+	}
+}
+
+// AddRoutes registers the feed route.
+func (h *Handler) AddRoutes(router *mux.Router) {
+	router.HandleFunc("/feeds/{token}.ics", h.serve).Methods(http.MethodGet, http.MethodHead)
+}
+
+func (h *Handler) serve(w http.ResponseWriter, r *http.Request) {
+	calDav, err := h.feeds.Execute(r.Context(), mux.Vars(r)["token"])
+	if errors.Is(err, shares.ErrInvalidToken) {
+		// Unknown and revoked tokens look the same as feeds that do not exist.
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		h.logger.Error("feed request failed", zap.String("method", r.Method), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if calDav == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("ETag", calDav.ETag)
+	w.Header().Set("Cache-Control", h.cacheControl)
+	// The token is a credential, keep it out of referrers of anything linked from the feed.
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	// ServeContent answers HEAD, If-None-Match and If-Modified-Since.
+	http.ServeContent(w, r, "", calDav.UpdatedAt, strings.NewReader(calDav.ICal.Serialize()))
+}
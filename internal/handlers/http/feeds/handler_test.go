@@ -0,0 +1,64 @@
+package feeds
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/services/shares"
+)
+
+type feedsFunc func(ctx context.Context, token string) (*entities.CalDav, error)
+
+func (f feedsFunc) Execute(ctx context.Context, token string) (*entities.CalDav, error) {
+	return f(ctx, token)
+}
+
+func TestServe(t *testing.T) {
+	cal := ics.NewCalendar()
+	cal.SetProductId("-//ITMO Calendar//EN")
+	updatedAt := time.Date(2025, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	router := mux.NewRouter()
+	NewHandler(feedsFunc(func(_ context.Context, token string) (*entities.CalDav, error) {
+		if token != "secret" {
+			return nil, shares.ErrInvalidToken
+		}
+		return &entities.CalDav{ISU: 1, ICal: cal, ETag: `"v1"`, UpdatedAt: updatedAt}, nil
+	}), "private, max-age=300", zap.NewNop()).AddRoutes(router)
+
+	serve := func(method, path string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(http.MethodGet, "/feeds/secret.ics", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `"v1"`, rec.Header().Get("ETag"))
+	assert.Equal(t, "private, max-age=300", rec.Header().Get("Cache-Control"))
+	assert.Contains(t, rec.Body.String(), "PRODID:-//ITMO Calendar//EN")
+
+	rec = serve(http.MethodGet, "/feeds/secret.ics", http.Header{"If-None-Match": {`"v1"`}})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	rec = serve(http.MethodHead, "/feeds/secret.ics", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	rec = serve(http.MethodGet, "/feeds/guess.ics", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -26,6 +26,7 @@ type Server struct {
 	logger   *zap.Logger
 	config   *config.HTTPServer
 	handlers []APIHandler
+	routes   []RouteHandler
 }
 
 // APIHandler defines the interface for API handlers.
@@ -34,6 +35,11 @@ type APIHandler interface {
 	GetVersion() string
 }
 
+// RouteHandler defines the interface for handlers mounted at the server root.
+type RouteHandler interface {
+	AddRoutes(r *mux.Router)
+}
+
 // Option defines a functional option for configuring the server.
 type Option func(*Server)
 
@@ -51,6 +57,33 @@ func WithAPIHandler(handler APIHandler) Option {
 	}
 }
 
+// WithRouteHandler adds a handler mounted at the server root, outside of the versioned API.
+func WithRouteHandler(handler RouteHandler) Option {
+	return func(s *Server) {
+		s.routes = append(s.routes, handler)
+	}
+}
+
+// Deprecated wraps a root handler so that all its responses carry a Deprecation header.
+func Deprecated(handler RouteHandler) RouteHandler {
+	return deprecatedRoutes{handler: handler}
+}
+
+type deprecatedRoutes struct {
+	handler RouteHandler
+}
+
+func (d deprecatedRoutes) AddRoutes(r *mux.Router) {
+	subrouter := r.NewRoute().Subrouter()
+	subrouter.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", "true")
+			next.ServeHTTP(w, r)
+		})
+	})
+	d.handler.AddRoutes(subrouter)
+}
+
 // New creates a new HTTP server with the provided configuration and options.
 func New(c *container.Container, cfg *config.HTTPServer, opts ...Option) (*Server, error) {
 	s := &Server{
@@ -89,6 +122,10 @@ func (s *Server) Start() error {
 		s.logger.Info("Registered API handler", zap.String("version", version))
 	}
 
+	for _, handler := range s.routes {
+		handler.AddRoutes(router)
+	}
+
 	s.registerDebugRoutes(router)
 
 	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
@@ -2,6 +2,8 @@ package http
 
 import (
 	"net/http"
+	"path"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -36,6 +38,31 @@ func (rw *ResponseWriter) Write(b []byte) (int, error) {
 	return size, err
 }
 
+// _secretPathPrefixes are followed by a secret feed token that must not end up in logs.
+var _secretPathPrefixes = []string{"/feeds/", "/caldav/"}
+
+// redactPath replaces the feed token of /feeds/{token}.ics and /caldav/{token}/... paths with {token}.
+func redactPath(p string) string {
+	for _, prefix := range _secretPathPrefixes {
+		rest, ok := strings.CutPrefix(p, prefix)
+		if !ok {
+			continue
+		}
+
+		end := strings.IndexByte(rest, '/')
+		if end < 0 {
+			end = len(rest)
+		}
+		if end == 0 {
+			return p
+		}
+
+		return prefix + "{token}" + path.Ext(rest[:end]) + rest[end:]
+	}
+
+	return p
+}
+
 // NewLoggingMiddleware returns a middleware that logs request and response details.
 func NewLoggingMiddleware(logger *zap.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -51,7 +78,7 @@ func NewLoggingMiddleware(logger *zap.Logger) func(http.Handler) http.Handler {
 			logger.Info("Request started",
 				zap.String("request_id", requestID),
 				zap.String("method", r.Method),
-				zap.String("path", r.URL.Path),
+				zap.String("path", redactPath(r.URL.Path)),
 				zap.String("remote_addr", r.RemoteAddr),
 				zap.String("user_agent", r.UserAgent()),
 			)
@@ -62,7 +89,7 @@ func NewLoggingMiddleware(logger *zap.Logger) func(http.Handler) http.Handler {
 			logger.Info("Request completed",
 				zap.String("request_id", requestID),
 				zap.String("method", r.Method),
-				zap.String("path", r.URL.Path),
+				zap.String("path", redactPath(r.URL.Path)),
 				zap.Int("status", rw.status),
 				zap.Duration("duration", duration),
 				zap.Int("size", rw.size),
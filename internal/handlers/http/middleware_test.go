@@ -0,0 +1,20 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactPath(t *testing.T) {
+	for path, want := range map[string]string{
+		"/feeds/3q2-7wZ5h8cQ_abcdefghij.ics":                       "/feeds/{token}.ics",
+		"/caldav/3q2-7wZ5h8cQ_abcdefghij/":                         "/caldav/{token}/",
+		"/caldav/3q2-7wZ5h8cQ_abcdefghij/calendars/schedule/a.ics": "/caldav/{token}/calendars/schedule/a.ics",
+		"/caldav/3q2-7wZ5h8cQ_abcdefghij":                          "/caldav/{token}",
+		"/caldav/":                                                 "/caldav/",
+		"/api/v1/123456/schedule":                                  "/api/v1/123456/schedule",
+	} {
+		assert.Equal(t, want, redactPath(path), path)
+	}
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/sessions"
+)
+
+const _bearerPrefix = "Bearer "
+
+// setUpAuth makes secured operations require an access token issued to the user in the path.
+func (h *Handler) setUpAuth() {
+	h.ops.APIKeyAuthenticator = func(name, in string, _ security.TokenAuthentication) runtime.Authenticator {
+		return security.APIKeyAuthCtx(name, in, h.authenticate)
+	}
+	h.ops.JWTAuth = func(token string) (*entities.User, error) {
+		_, principal, err := h.authenticate(context.Background(), token)
+		if err != nil {
+			return nil, err
+		}
+
+		return principal.(*entities.User), nil
+	}
+	h.ops.APIAuthorizer = runtime.AuthorizerFunc(h.authorize)
+}
+
+// authenticate resolves the user of a bearer access token.
+func (h *Handler) authenticate(ctx context.Context, header string) (context.Context, interface{}, error) {
+	token, ok := strings.CutPrefix(header, _bearerPrefix)
+	if !ok || token == "" {
+		return ctx, nil, oaerrors.Unauthenticated("bearer")
+	}
+
+	user, err := h.usecases.Authenticate.Execute(ctx, token)
+	if errors.Is(err, sessions.ErrInvalidSession) {
+		return ctx, nil, oaerrors.Unauthenticated("bearer")
+	}
+	if err != nil {
+		h.logger.Error("authenticate", zap.Error(err))
+		return ctx, nil, oaerrors.New(http.StatusInternalServerError, "authenticate")
+	}
+
+	return ctx, user, nil
+}
+
+// authorize only lets users access their own data.
+func (h *Handler) authorize(r *http.Request, principal interface{}) error {
+	user, ok := principal.(*entities.User)
+	if !ok {
+		return oaerrors.New(http.StatusForbidden, "forbidden")
+	}
+
+	route := middleware.MatchedRouteFrom(r)
+	if route == nil {
+		return nil
+	}
+
+	raw := route.Params.Get("isu")
+	if raw == "" {
+		return nil
+	}
+
+	isu, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || isu != user.ISU {
+		return oaerrors.New(http.StatusForbidden, "forbidden")
+	}
+
+	return nil
+}
+
+// serveError renders authentication failures like other API errors.
+func (h *Handler) serveError(w http.ResponseWriter, r *http.Request, err error) {
+	var apiErr oaerrors.Error
+	if !errors.As(err, &apiErr) {
+		oaerrors.ServeError(w, r, err)
+		return
+	}
+
+	var payload *models.Error
+	switch apiErr.Code() {
+	case http.StatusUnauthorized:
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		payload = &models.Error{
+			Error:   "Unauthorized",
+			Message: i18n.T(locale(r), i18n.ErrUnauthorized),
+		}
+	case http.StatusForbidden:
+		payload = &models.Error{
+			Error:   "Forbidden",
+			Message: i18n.T(locale(r), i18n.ErrForbidden),
+		}
+	default:
+		oaerrors.ServeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(apiErr.Code()))
+	_ = json.NewEncoder(w).Encode(payload)
+}
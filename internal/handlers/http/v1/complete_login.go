@@ -0,0 +1,60 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiAuth "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/auth"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/logins"
+)
+
+func (h *Handler) CompleteLoginHandler(params apiAuth.CompleteLoginParams) middleware.Responder {
+	r := params.HTTPRequest
+	code, state := swag.StringValue(params.Code), swag.StringValue(params.State)
+
+	if params.Error != nil || code == "" || state == "" {
+		h.logger.Info("ITMO ID login failed", zap.String("error", swag.StringValue(params.Error)))
+		return apiAuth.NewCompleteLoginBadRequest().WithPayload(&models.Error{
+			Error:   "BadRequest",
+			Message: i18n.T(locale(r), i18n.ErrLoginFailed),
+		})
+	}
+
+	cookie, err := r.Cookie(_loginCookie)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		return apiAuth.NewCompleteLoginBadRequest().WithPayload(&models.Error{
+			Error:   "BadRequest",
+			Message: i18n.T(locale(r), i18n.ErrLoginStateMismatch),
+		})
+	}
+
+	subscription, err := h.usecases.SubscirbeSchedule.Execute(r.Context(), entities.Credentials{
+		State: state,
+		Code:  code,
+	})
+	if errors.Is(err, logins.ErrInvalidLogin) {
+		return apiAuth.NewCompleteLoginBadRequest().WithPayload(&models.Error{
+			Error:   "BadRequest",
+			Message: i18n.T(locale(r), i18n.ErrLoginExpired),
+		})
+	}
+	if err != nil {
+		return h.fail(r, "complete login", err)
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		http.SetCookie(w, loginCookie(r, "", -1))
+		apiAuth.NewCompleteLoginOK().WithPayload(subscriptionToDTO(r, subscription)).WriteResponse(w, p)
+	})
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations"
 )
 
-//go:generate swagger generate server --target ../../v1 --name ItmoCalendar --spec ../../../../../swagger.yml --template-dir ./swagger-templates/templates --principal github.com/Verity-Chain/VerityChain/itmo-calendar/internal/entities.User
+//go:generate swagger generate server --target ../../v1 --name ItmoCalendar --spec ../../../../../swagger.yml --template-dir ./swagger-templates/templates --principal github.com/hexarchy/itmo-calendar/internal/entities.User
 
 //lint:ignore U1000 example
 func configureFlags(api *operations.ItmoCalendarAPI) {
@@ -0,0 +1,27 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func (h *Handler) DeleteCalDavTargetHandler(params apiSettings.DeleteCalDavTargetParams, principal *entities.User) middleware.Responder {
+	deleted, err := h.usecases.DeleteCalDavTarget.Execute(params.HTTPRequest.Context(), params.Isu)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "delete caldav target", err)
+	}
+	if !deleted {
+		return apiSettings.NewDeleteCalDavTargetNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrTargetNotFound),
+		})
+	}
+
+	return apiSettings.NewDeleteCalDavTargetNoContent()
+}
@@ -0,0 +1,27 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiCalDav "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/cal_dav"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func (h *Handler) DeleteSubscriptionHandler(params apiCalDav.DeleteSubscriptionParams, principal *entities.User) middleware.Responder {
+	deleted, err := h.usecases.DeleteSubscription.Execute(params.HTTPRequest.Context(), principal.ISU)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "delete subscription", err)
+	}
+	if !deleted {
+		return apiCalDav.NewDeleteSubscriptionNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrUserNotFound),
+		})
+	}
+
+	return apiCalDav.NewDeleteSubscriptionNoContent()
+}
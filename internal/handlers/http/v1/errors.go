@@ -0,0 +1,75 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/ical"
+	"github.com/hexarchy/itmo-calendar/internal/services/logins"
+	"github.com/hexarchy/itmo-calendar/internal/services/meetings"
+	"github.com/hexarchy/itmo-calendar/internal/services/reminders"
+)
+
+// failure is how an error is reported to clients, its code is part of the API and must not change.
+type failure struct {
+	target error
+	status int
+	code   string
+	key    i18n.Key
+}
+
+// _failures map domain errors to responses, the first match wins.
+var _failures = []failure{
+	{entities.ErrInvalidCredentials, http.StatusUnauthorized, "invalid_credentials", i18n.ErrInvalidCredentials},
+	{entities.ErrTokenExpired, http.StatusUnauthorized, "token_expired", i18n.ErrTokenExpired},
+	{entities.ErrUserNotFound, http.StatusNotFound, "user_not_found", i18n.ErrUserNotFound},
+	{entities.ErrRateLimited, http.StatusTooManyRequests, "rate_limited", i18n.ErrRateLimited},
+	{entities.ErrUpstreamUnavailable, http.StatusBadGateway, "upstream_unavailable", i18n.ErrUpstreamUnavailable},
+	{logins.ErrInvalidLogin, http.StatusBadRequest, "login_expired", i18n.ErrLoginExpired},
+	{logins.ErrAnswerMissing, http.StatusBadRequest, "answer_missing", i18n.ErrAnswerMissing},
+	{ical.ErrInvalidTemplate, http.StatusBadRequest, "invalid_template", i18n.ErrInvalidTemplate},
+	{reminders.ErrInvalidRules, http.StatusBadRequest, "invalid_reminders", i18n.ErrInvalidReminders},
+	{meetings.ErrInvalidQuery, http.StatusBadRequest, "invalid_meeting_query", i18n.ErrInvalidMeetingQuery},
+}
+
+var _internalFailure = failure{status: http.StatusInternalServerError, code: "internal", key: i18n.ErrInternal}
+
+// fail answers with the response of the domain error. The error chain may hold upstream bodies,
+// so it is only logged.
+func (h *Handler) fail(r *http.Request, op string, err error) middleware.Responder {
+	f := _internalFailure
+	for _, candidate := range _failures {
+		if errors.Is(err, candidate.target) {
+			f = candidate
+			break
+		}
+	}
+
+	log := h.logger.Warn
+	if f.status >= http.StatusInternalServerError {
+		log = h.logger.Error
+	}
+	log(op, zap.String("code", f.code), zap.Error(err))
+
+	payload := &models.Error{
+		Error:   strings.ReplaceAll(http.StatusText(f.status), " ", ""),
+		Code:    f.code,
+		Message: i18n.T(locale(r), f.key),
+	}
+
+	// Written as JSON whatever the operation produces, e.g. for the text/calendar feed.
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(f.status)
+		_ = json.NewEncoder(w).Encode(payload)
+	})
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	"github.com/hexarchy/itmo-calendar/internal/services/ical"
+	"github.com/hexarchy/itmo-calendar/internal/services/meetings"
+	"github.com/hexarchy/itmo-calendar/internal/services/reminders"
+)
+
+func TestFail(t *testing.T) {
+	h := &Handler{logger: zap.NewNop()}
+
+	tests := []struct {
+		err    error
+		status int
+		code   string
+	}{
+		{errors.Wrap(entities.ErrInvalidCredentials, "password login"), http.StatusUnauthorized, "invalid_credentials"},
+		{errors.Wrap(entities.ErrReauthRequired, "refresh token expired"), http.StatusUnauthorized, "token_expired"},
+		{errors.Wrap(entities.ErrUserNotFound, "get sync status"), http.StatusNotFound, "user_not_found"},
+		{errors.Wrap(entities.ErrRateLimited, "status 429"), http.StatusTooManyRequests, "rate_limited"},
+		{errors.Wrapf(entities.ErrUpstreamUnavailable, "status 503: %s", "<html>nginx</html>"), http.StatusBadGateway, "upstream_unavailable"},
+		{errors.Wrap(ical.ErrInvalidTemplate, `summary: template: summary:1: function "x" not defined`), http.StatusBadRequest, "invalid_template"},
+		{errors.Wrap(reminders.ErrInvalidRules, "minutes before -5"), http.StatusBadRequest, "invalid_reminders"},
+		{errors.Wrap(meetings.ErrInvalidQuery, "range exceeds 31 days"), http.StatusBadRequest, "invalid_meeting_query"},
+		{errors.New("caldav repository: get: connection refused"), http.StatusInternalServerError, "internal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/status", nil)
+			rec := httptest.NewRecorder()
+			h.fail(req, "test", tt.err).WriteResponse(rec, nil)
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var payload models.Error
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&payload))
+			assert.Equal(t, tt.code, payload.Code)
+			assert.NotContains(t, payload.Message, tt.err.Error())
+		})
+	}
+}
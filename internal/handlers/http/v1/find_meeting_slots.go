@@ -0,0 +1,105 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSchedule "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/schedule"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/shares"
+	findmeetingslots "github.com/hexarchy/itmo-calendar/internal/use-cases/find-meeting-slots"
+)
+
+const (
+	_defaultDayStart     = "09:00"
+	_defaultDayEnd       = "21:00"
+	_defaultMeetingSlots = 10
+)
+
+func (h *Handler) FindMeetingSlotsHandler(params apiSchedule.FindMeetingSlotsParams) middleware.Responder {
+	body := params.Body
+
+	participants := make([]entities.MeetingParticipant, 0, len(body.Participants))
+	for _, participant := range body.Participants {
+		if participant == nil || participant.Token == nil {
+			continue
+		}
+		participants = append(participants, entities.MeetingParticipant{
+			ISU:   participant.Isu,
+			Token: *participant.Token,
+		})
+	}
+
+	query := entities.MeetingQuery{
+		From:        time.Time(*body.From),
+		To:          time.Time(*body.To),
+		MinDuration: time.Duration(*body.MinDuration) * time.Minute,
+		DayStart:    clock(body.DayStart, _defaultDayStart),
+		DayEnd:      clock(body.DayEnd, _defaultDayEnd),
+		TimeZone:    body.TimeZone,
+		Limit:       int(body.Limit),
+	}
+	if body.TravelMinutes != nil {
+		query.Travel = time.Duration(*body.TravelMinutes) * time.Minute
+	}
+	if query.Limit == 0 {
+		query.Limit = _defaultMeetingSlots
+	}
+
+	slots, err := h.usecases.FindMeetingSlots.Execute(params.HTTPRequest.Context(), participants, query)
+	if err != nil {
+		switch {
+		case errors.Is(err, shares.ErrInvalidToken), errors.Is(err, findmeetingslots.ErrNoConsent):
+			return apiSchedule.NewFindMeetingSlotsForbidden().WithPayload(&models.Error{
+				Error:   "Forbidden",
+				Message: i18n.T(locale(params.HTTPRequest), i18n.ErrInvalidShareToken),
+			})
+		case errors.Is(err, findmeetingslots.ErrNoSchedule):
+			return apiSchedule.NewFindMeetingSlotsNotFound().WithPayload(&models.Error{
+				Error:   "NotFound",
+				Message: i18n.T(locale(params.HTTPRequest), i18n.ErrScheduleNotFound),
+			})
+		}
+
+		return h.fail(params.HTTPRequest, "find meeting slots", err)
+	}
+
+	dto := &models.MeetingSlots{
+		Slots: make([]*models.MeetingSlot, 0, len(slots)),
+	}
+	for _, slot := range slots {
+		start := strfmt.DateTime(slot.Start)
+		end := strfmt.DateTime(slot.End)
+		minutes := int64(slot.Duration() / time.Minute)
+		dto.Slots = append(dto.Slots, &models.MeetingSlot{
+			Start:           &start,
+			End:             &end,
+			DurationMinutes: &minutes,
+		})
+	}
+
+	return apiSchedule.NewFindMeetingSlotsOK().WithPayload(dto)
+}
+
+// clock converts an "HH:MM" time of day, already checked against the spec pattern, to an offset from midnight.
+func clock(value *string, fallback string) time.Duration {
+	hhmm := fallback
+	if value != nil && *value != "" {
+		hhmm = *value
+	}
+
+	hours, minutes, _ := strings.Cut(hhmm, ":")
+	h, _ := strconv.Atoi(hours)
+	m, _ := strconv.Atoi(minutes)
+
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+}
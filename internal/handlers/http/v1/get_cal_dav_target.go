@@ -0,0 +1,41 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func (h *Handler) GetCalDavTargetHandler(params apiSettings.GetCalDavTargetParams, principal *entities.User) middleware.Responder {
+	target, found, err := h.usecases.GetCalDavTarget.Execute(params.HTTPRequest.Context(), params.Isu)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "get caldav target", err)
+	}
+	if !found {
+		return apiSettings.NewGetCalDavTargetNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrUserNotFound),
+		})
+	}
+	if target == nil {
+		return apiSettings.NewGetCalDavTargetNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrTargetNotFound),
+		})
+	}
+
+	return apiSettings.NewGetCalDavTargetOK().WithPayload(calDavTargetToDTO(*target))
+}
+
+// calDavTargetToDTO converts a CalDAV target to the API model, the password is never returned.
+func calDavTargetToDTO(target entities.CalDavTarget) *models.CalDavTarget {
+	return &models.CalDavTarget{
+		URL:      &target.URL,
+		Username: target.Username,
+	}
+}
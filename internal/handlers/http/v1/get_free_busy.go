@@ -0,0 +1,103 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSchedule "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/schedule"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/shares"
+	getfreebusy "github.com/hexarchy/itmo-calendar/internal/use-cases/get-free-busy"
+)
+
+func (h *Handler) GetFreeBusyHandler(params apiSchedule.GetFreeBusyParams) middleware.Responder {
+	query := getfreebusy.Query{
+		ISU:   params.Isu,
+		Token: params.Token,
+	}
+	if params.From != nil {
+		from := time.Time(*params.From)
+		query.From = &from
+	}
+	if params.To != nil {
+		to := time.Time(*params.To)
+		query.To = &to
+	}
+	if params.PaddingBefore != nil {
+		query.PadBefore = time.Duration(*params.PaddingBefore) * time.Minute
+	}
+	if params.PaddingAfter != nil {
+		query.PadAfter = time.Duration(*params.PaddingAfter) * time.Minute
+	}
+
+	fb, cal, err := h.usecases.GetFreeBusy.Execute(params.HTTPRequest.Context(), query)
+	if err != nil {
+		switch {
+		case errors.Is(err, getfreebusy.ErrInvalidRange):
+			return apiSchedule.NewGetFreeBusyBadRequest().WithPayload(&models.Error{
+				Error:   "BadRequest",
+				Message: i18n.T(locale(params.HTTPRequest), i18n.ErrInvalidRange),
+			})
+		case errors.Is(err, shares.ErrInvalidToken):
+			return apiSchedule.NewGetFreeBusyUnauthorized().WithPayload(&models.Error{
+				Error:   "Unauthorized",
+				Message: i18n.T(locale(params.HTTPRequest), i18n.ErrInvalidShareToken),
+			})
+		}
+
+		return h.fail(params.HTTPRequest, "get free busy", err)
+	}
+	if fb == nil {
+		return apiSchedule.NewGetFreeBusyNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrScheduleNotFound),
+		})
+	}
+
+	if params.Format != nil && *params.Format == "json" {
+		return apiSchedule.NewGetFreeBusyOK().WithPayload(freeBusyToDTO(*fb))
+	}
+
+	// The format is chosen by the query rather than Accept, so the calendar is written directly.
+	body := cal.Serialize()
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		rw.WriteHeader(http.StatusOK)
+		_, err := rw.Write([]byte(body))
+		if err != nil {
+			h.logger.Warn("failed to write free/busy calendar", zap.Error(err))
+		}
+	})
+}
+
+// freeBusyToDTO converts busy periods to the API model.
+func freeBusyToDTO(fb entities.FreeBusy) *models.FreeBusy {
+	from := strfmt.DateTime(fb.From)
+	to := strfmt.DateTime(fb.To)
+	dto := &models.FreeBusy{
+		From: &from,
+		To:   &to,
+		Busy: make([]*models.BusyPeriod, 0, len(fb.Busy)),
+	}
+
+	for _, period := range fb.Busy {
+		start := strfmt.DateTime(period.Start)
+		end := strfmt.DateTime(period.End)
+		dto.Busy = append(dto.Busy, &models.BusyPeriod{
+			Start: &start,
+			End:   &end,
+		})
+	}
+
+	return dto
+}
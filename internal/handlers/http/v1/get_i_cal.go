@@ -3,29 +3,67 @@
 package api
 
 import (
-	"bytes"
 	"io"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 
+	"github.com/hexarchy/itmo-calendar/internal/entities"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
 	apiCalDav "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/cal_dav"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
 )
 
-func (h *Handler) GetICalHandler(params apiCalDav.GetICalParams) middleware.Responder {
-	ical, err := h.usecases.GetICal.Execute(params.HTTPRequest.Context(), params.Isu)
+func (h *Handler) GetICalHandler(params apiCalDav.GetICalParams, principal *entities.User) middleware.Responder {
+	calDav, err := h.usecases.GetICal.Execute(params.HTTPRequest.Context(), params.Isu)
 	if err != nil {
-		return apiCalDav.NewGetICalInternalServerError().WithPayload(&models.Error{
-			Error:   "InternalServerError",
-			Message: err.Error(),
-		})
+		return h.fail(params.HTTPRequest, "get ical", err)
 	}
-	if ical == nil {
+	if calDav == nil {
 		return apiCalDav.NewGetICalNotFound().WithPayload(&models.Error{
 			Error:   "NotFound",
-			Message: "iCal not found",
+			Code:    "ical_not_found",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrICalNotFound),
 		})
 	}
 
-	return apiCalDav.NewGetICalOK().WithPayload(io.NopCloser(bytes.NewReader([]byte(ical.Serialize()))))
+	lastModified := calDav.UpdatedAt.UTC().Format(http.TimeFormat)
+	if notModified(params.IfNoneMatch, params.IfModifiedSince, calDav.ETag, calDav.UpdatedAt) {
+		return apiCalDav.NewGetICalNotModified().
+			WithETag(calDav.ETag).
+			WithLastModified(lastModified).
+			WithCacheControl(h.cacheControl)
+	}
+
+	return apiCalDav.NewGetICalOK().
+		WithETag(calDav.ETag).
+		WithLastModified(lastModified).
+		WithCacheControl(h.cacheControl).
+		WithPayload(io.NopCloser(strings.NewReader(calDav.ICal.Serialize())))
+}
+
+// notModified evaluates conditional request headers against the feed validators.
+// If-Modified-Since is only considered without If-None-Match, as RFC 9110 requires.
+func notModified(ifNoneMatch, ifModifiedSince *string, etag string, updatedAt time.Time) bool {
+	if ifNoneMatch != nil {
+		for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince != nil {
+		since, err := http.ParseTime(*ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		return !updatedAt.Truncate(time.Second).After(since)
+	}
+
+	return false
 }
@@ -0,0 +1,48 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func (h *Handler) GetRemindersHandler(params apiSettings.GetRemindersParams, principal *entities.User) middleware.Responder {
+	rules, found, err := h.usecases.GetReminders.Execute(params.HTTPRequest.Context(), params.Isu)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "get reminders", err)
+	}
+	if !found {
+		return apiSettings.NewGetRemindersNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrUserNotFound),
+		})
+	}
+
+	return apiSettings.NewGetRemindersOK().WithPayload(remindersToDTO(rules))
+}
+
+// remindersToDTO converts reminder rules to the API model.
+func remindersToDTO(rules []entities.ReminderRule) *models.Reminders {
+	dto := &models.Reminders{
+		Rules: make([]*models.ReminderRule, 0, len(rules)),
+	}
+
+	for _, rule := range rules {
+		ruleDTO := &models.ReminderRule{
+			LessonType: rule.LessonType,
+			Format:     rule.Format,
+		}
+		if rule.MinutesBefore != nil {
+			minutes := int64(*rule.MinutesBefore)
+			ruleDTO.MinutesBefore = &minutes
+		}
+		dto.Rules = append(dto.Rules, ruleDTO)
+	}
+
+	return dto
+}
@@ -6,22 +6,22 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/hexarchy/itmo-calendar/internal/entities"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
 	apiSchedule "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/schedule"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
 )
 
-func (h *Handler) GetScheduleHandler(params apiSchedule.GetScheduleParams) middleware.Responder {
+func (h *Handler) GetScheduleHandler(params apiSchedule.GetScheduleParams, principal *entities.User) middleware.Responder {
 	schedule, err := h.usecases.GetSchedule.Execute(params.HTTPRequest.Context(), params.Isu)
 	if err != nil {
-		return apiSchedule.NewGetScheduleInternalServerError().WithPayload(&models.Error{
-			Error:   "InternalServerError",
-			Message: err.Error(),
-		})
+		return h.fail(params.HTTPRequest, "get schedule", err)
 	}
 	if schedule == nil {
 		return apiSchedule.NewGetScheduleNotFound().WithPayload(&models.Error{
 			Error:   "NotFound",
-			Message: "schedule not found",
+			Code:    "schedule_not_found",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrScheduleNotFound),
 		})
 	}
 
@@ -29,26 +29,14 @@ func (h *Handler) GetScheduleHandler(params apiSchedule.GetScheduleParams) middl
 
 	for _, daySchedule := range schedule {
 		date := strfmt.Date(daySchedule.Date)
-		lessonsDTO := make([]*models.ScheduleItemLessonsItems0, 0, len(daySchedule.Lessons))
+		lessonsDTO := make([]*models.Lesson, 0, len(daySchedule.Lessons))
 		for _, lesson := range daySchedule.Lessons {
-			lessonDTO := &models.ScheduleItemLessonsItems0{
-				Subject:     &lesson.Subject,
-				Type:        &lesson.Type,
-				TeacherName: &lesson.TeacherName,
-				Room:        &lesson.Room,
-				Note:        lesson.Note,
-				Building:    &lesson.Building,
-				Format:      &lesson.Format,
-				Group:       &lesson.Group,
-				ZoomURL:     lesson.ZoomURL,
-				TimeStart:   (*strfmt.DateTime)(&lesson.Start),
-				TimeEnd:     (*strfmt.DateTime)(&lesson.End),
-			}
-			lessonsDTO = append(lessonsDTO, lessonDTO)
+			lessonsDTO = append(lessonsDTO, lessonToDTO(lesson))
 		}
 
 		scheduleItemDTO := &models.ScheduleItem{
 			Date:    &date,
+			Week:    weekToDTO(daySchedule.Week),
 			Lessons: lessonsDTO,
 		}
 
@@ -57,3 +45,40 @@ func (h *Handler) GetScheduleHandler(params apiSchedule.GetScheduleParams) middl
 
 	return apiSchedule.NewGetScheduleOK().WithPayload(scheduleDTO)
 }
+
+// lessonToDTO converts a lesson to the API model.
+func lessonToDTO(lesson entities.Lesson) *models.Lesson {
+	return &models.Lesson{
+		Subject:     &lesson.Subject,
+		Type:        &lesson.Type,
+		TeacherName: &lesson.TeacherName,
+		Room:        &lesson.Room,
+		Note:        lesson.Note,
+		Building:    &lesson.Building,
+		Format:      &lesson.Format,
+		Group:       &lesson.Group,
+		ZoomURL:     lesson.ZoomURL,
+		TimeStart:   (*strfmt.DateTime)(&lesson.Start),
+		TimeEnd:     (*strfmt.DateTime)(&lesson.End),
+	}
+}
+
+// weekToDTO converts an academic week to the API model, nil outside of the academic calendar.
+func weekToDTO(week *entities.AcademicWeek) *models.AcademicWeek {
+	if week == nil {
+		return nil
+	}
+
+	number := int64(week.Number)
+	parity := "even"
+	if week.Odd {
+		parity = "odd"
+	}
+	period := string(week.Period)
+
+	return &models.AcademicWeek{
+		Number: &number,
+		Parity: &parity,
+		Period: &period,
+	}
+}
@@ -0,0 +1,62 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSchedule "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/schedule"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/changes"
+)
+
+func (h *Handler) GetScheduleChangesHandler(params apiSchedule.GetScheduleChangesParams, principal *entities.User) middleware.Responder {
+	var token string
+	if params.SyncToken != nil {
+		token = *params.SyncToken
+	}
+
+	result, err := h.usecases.GetScheduleChanges.Execute(params.HTTPRequest.Context(), params.Isu, token)
+	if err != nil {
+		if errors.Is(err, changes.ErrSyncTokenExpired) {
+			return apiSchedule.NewGetScheduleChangesGone().WithPayload(&models.Error{
+				Error:   "SyncTokenExpired",
+				Message: i18n.T(locale(params.HTTPRequest), i18n.ErrSyncTokenExpired),
+			})
+		}
+
+		return h.fail(params.HTTPRequest, "get schedule changes", err)
+	}
+	if result == nil {
+		return apiSchedule.NewGetScheduleChangesNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrScheduleNotFound),
+		})
+	}
+
+	changesDTO := make([]*models.ScheduleChange, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		date := strfmt.Date(change.Date)
+		changeType := string(change.Type)
+
+		changeDTO := &models.ScheduleChange{
+			UID:  &change.UID,
+			Type: &changeType,
+			Date: &date,
+		}
+		if change.Lesson != nil {
+			changeDTO.Lesson = lessonToDTO(*change.Lesson)
+		}
+
+		changesDTO = append(changesDTO, changeDTO)
+	}
+
+	return apiSchedule.NewGetScheduleChangesOK().WithPayload(&models.ScheduleChanges{
+		SyncToken: &result.SyncToken,
+		Changes:   changesDTO,
+	})
+}
@@ -0,0 +1,61 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func (h *Handler) GetSettingsHandler(params apiSettings.GetSettingsParams, principal *entities.User) middleware.Responder {
+	settings, err := h.usecases.GetSettings.Execute(params.HTTPRequest.Context(), params.Isu)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "get settings", err)
+	}
+	if settings == nil {
+		return apiSettings.NewGetSettingsNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrUserNotFound),
+		})
+	}
+
+	return apiSettings.NewGetSettingsOK().WithPayload(settingsToDTO(*settings))
+}
+
+// settingsToDTO converts user settings to the API model.
+func settingsToDTO(settings entities.UserSettings) *models.UserSettings {
+	return &models.UserSettings{
+		TimeZone:           settings.TimeZone,
+		Locale:             settings.Locale,
+		CompressRecurrence: settings.CompressRecurrence,
+		WeekMarkers:        settings.WeekMarkers,
+		Templates: &models.EventTemplates{
+			Summary:     settings.Templates.Summary,
+			Description: settings.Templates.Description,
+			Location:    settings.Templates.Location,
+		},
+	}
+}
+
+// settingsFromDTO converts the API model to user settings.
+func settingsFromDTO(dto *models.UserSettings) entities.UserSettings {
+	settings := entities.UserSettings{
+		TimeZone:           dto.TimeZone,
+		Locale:             dto.Locale,
+		CompressRecurrence: dto.CompressRecurrence,
+		WeekMarkers:        dto.WeekMarkers,
+	}
+	if dto.Templates != nil {
+		settings.Templates = entities.EventTemplates{
+			Summary:     dto.Templates.Summary,
+			Description: dto.Templates.Description,
+			Location:    dto.Templates.Location,
+		}
+	}
+
+	return settings
+}
@@ -0,0 +1,43 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiCalDav "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/cal_dav"
+)
+
+func (h *Handler) GetSyncStatusHandler(params apiCalDav.GetSyncStatusParams, principal *entities.User) middleware.Responder {
+	state, err := h.usecases.GetSyncStatus.Execute(params.HTTPRequest.Context(), principal.ISU)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "get sync status", err)
+	}
+
+	payload := &models.SyncStatus{
+		Status:          string(state.Status),
+		StatusChangedAt: dateTime(state.StatusChangedAt),
+		LastSyncedAt:    dateTime(state.LastSyncedAt),
+		LastFailedAt:    dateTime(state.LastFailedAt),
+		TokensExpireAt:  dateTime(state.TokensExpireAt),
+	}
+	if !state.Status.Active() {
+		payload.ResubscribeURL = "/api/v1/auth/start"
+	}
+
+	return apiCalDav.NewGetSyncStatusOK().WithPayload(payload)
+}
+
+func dateTime(t *time.Time) *strfmt.DateTime {
+	if t == nil {
+		return nil
+	}
+
+	dt := strfmt.DateTime(*t)
+	return &dt
+}
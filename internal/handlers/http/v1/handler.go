@@ -3,35 +3,53 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/go-openapi/loads"
 	"go.uber.org/zap"
 
 	"github.com/hexarchy/itmo-calendar/internal/app/container"
+	"github.com/hexarchy/itmo-calendar/internal/config"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
 
+	apiAuth "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/auth"
 	apiCalDav "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/cal_dav"
 	apiSchedule "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/schedule"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
 	apiSystem "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/system"
 )
 
+// _legacyRoutes serve calendars by bare ISU, they are only enabled by HTTPServer.LegacyISUPaths.
+var _legacyRoutes = []struct{ method, path string }{
+	{http.MethodGet, "/{isu}/ical"},
+	{http.MethodHead, "/{isu}/ical"},
+	{http.MethodGet, "/{isu}/schedule"},
+	{http.MethodGet, "/{isu}/schedule/changes"},
+}
+
 type Handler struct {
-	ops      *operations.ItmoCalendarAPI
-	usecases *container.UseCases
-	logger   *zap.Logger
+	ops            *operations.ItmoCalendarAPI
+	usecases       *container.UseCases
+	cacheControl   string
+	legacyISUPaths bool
+	logger         *zap.Logger
 }
 
-func NewHandler(usecases *container.UseCases, logger *zap.Logger) (*Handler, error) {
+func NewHandler(usecases *container.UseCases, cacheControl *config.CacheControl, legacyISUPaths bool, logger *zap.Logger) (*Handler, error) {
 	swaggerSpec, err := loads.Analyzed(restapi.SwaggerJSON, "")
 	if err != nil {
 		return nil, err
 	}
 	r := &Handler{
-		ops:      operations.NewItmoCalendarAPI(swaggerSpec),
-		usecases: usecases,
-		logger:   logger.With(zap.String("component", "api_handler")),
+		ops:            operations.NewItmoCalendarAPI(swaggerSpec),
+		usecases:       usecases,
+		cacheControl:   cacheControl.Header(),
+		legacyISUPaths: legacyISUPaths,
+		logger:         logger.With(zap.String("component", "api_handler")),
 	}
 	r.setUpHandlers()
 
@@ -44,17 +62,67 @@ func (h *Handler) handlerFor(method, path string) http.Handler {
 	return r
 }
 
+// locale returns the language of API messages accepted by the client.
+func locale(r *http.Request) i18n.Locale {
+	return i18n.FromAcceptLanguage(r.Header.Get("Accept-Language"), i18n.EN)
+}
+
+// legacy marks responses of ISU paths as deprecated, or answers 404 when they are disabled.
+func (h *Handler) legacy(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !h.legacyISUPaths {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(&models.Error{
+				Error:   "NotFound",
+				Message: i18n.T(locale(r), i18n.ErrLegacyPathDisabled),
+			})
+			return
+		}
+
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", `</feeds/{token}.ics>; rel="successor-version"`)
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (h *Handler) setUpHandlers() {
 	h.ops.SystemHealthCheckHandler = apiSystem.HealthCheckHandlerFunc(h.HealthCheckHandler)
 	h.ops.CalDavGetICalHandler = apiCalDav.GetICalHandlerFunc(h.GetICalHandler)
+	h.ops.CalDavHeadICalHandler = apiCalDav.HeadICalHandlerFunc(h.HeadICalHandler)
 	h.ops.CalDavSubscribeScheduleHandler = apiCalDav.SubscribeScheduleHandlerFunc(h.SubscribeScheduleHandler)
+	h.ops.CalDavDeleteSubscriptionHandler = apiCalDav.DeleteSubscriptionHandlerFunc(h.DeleteSubscriptionHandler)
+	h.ops.CalDavGetSyncStatusHandler = apiCalDav.GetSyncStatusHandlerFunc(h.GetSyncStatusHandler)
 	h.ops.ScheduleGetScheduleHandler = apiSchedule.GetScheduleHandlerFunc(h.GetScheduleHandler)
+	h.ops.ScheduleGetScheduleChangesHandler = apiSchedule.GetScheduleChangesHandlerFunc(h.GetScheduleChangesHandler)
+	h.ops.SettingsGetSettingsHandler = apiSettings.GetSettingsHandlerFunc(h.GetSettingsHandler)
+	h.ops.SettingsUpdateSettingsHandler = apiSettings.UpdateSettingsHandlerFunc(h.UpdateSettingsHandler)
+	h.ops.SettingsGetRemindersHandler = apiSettings.GetRemindersHandlerFunc(h.GetRemindersHandler)
+	h.ops.SettingsUpdateRemindersHandler = apiSettings.UpdateRemindersHandlerFunc(h.UpdateRemindersHandler)
+	h.ops.SettingsGetCalDavTargetHandler = apiSettings.GetCalDavTargetHandlerFunc(h.GetCalDavTargetHandler)
+	h.ops.SettingsUpdateCalDavTargetHandler = apiSettings.UpdateCalDavTargetHandlerFunc(h.UpdateCalDavTargetHandler)
+	h.ops.SettingsDeleteCalDavTargetHandler = apiSettings.DeleteCalDavTargetHandlerFunc(h.DeleteCalDavTargetHandler)
+	h.ops.ScheduleGetFreeBusyHandler = apiSchedule.GetFreeBusyHandlerFunc(h.GetFreeBusyHandler)
+	h.ops.ScheduleFindMeetingSlotsHandler = apiSchedule.FindMeetingSlotsHandlerFunc(h.FindMeetingSlotsHandler)
+	h.ops.SettingsRotateFreeBusyTokenHandler = apiSettings.RotateFreeBusyTokenHandlerFunc(h.RotateFreeBusyTokenHandler)
+	h.ops.SettingsRevokeFreeBusyTokenHandler = apiSettings.RevokeFreeBusyTokenHandlerFunc(h.RevokeFreeBusyTokenHandler)
+	h.ops.SettingsRotateFeedTokenHandler = apiSettings.RotateFeedTokenHandlerFunc(h.RotateFeedTokenHandler)
+	h.ops.SettingsRevokeFeedTokenHandler = apiSettings.RevokeFeedTokenHandlerFunc(h.RevokeFeedTokenHandler)
+	h.ops.AuthRefreshSessionHandler = apiAuth.RefreshSessionHandlerFunc(h.RefreshSessionHandler)
+	h.ops.AuthLogoutHandler = apiAuth.LogoutHandlerFunc(h.LogoutHandler)
+	h.ops.AuthStartLoginHandler = apiAuth.StartLoginHandlerFunc(h.StartLoginHandler)
+	h.ops.AuthCompleteLoginHandler = apiAuth.CompleteLoginHandlerFunc(h.CompleteLoginHandler)
+	h.setUpAuth()
 
 	// You can add your middleware to concrete route
 	// h.ops.AddMiddlewareFor("%method%", "%route%", %middlewareBuilder%)
+	for _, route := range _legacyRoutes {
+		h.ops.AddMiddlewareFor(route.method, route.path, h.legacy)
+	}
 
 	// You can add your global middleware
 	// h.ops.AddGlobalMiddleware(%middlewareBuilder%)
 
 	configureAPI(h.ops)
+	h.ops.ServeError = h.serveError
 }
@@ -13,10 +13,31 @@ const version = "1.0.0"
 
 func (h *Handler) AddRoutes(router *mux.Router) {
 
+	router.Handle("/auth/callback", h.handlerFor("GET", "/auth/callback")).Methods("GET")
+	router.Handle("/{isu}/caldav-target", h.handlerFor("DELETE", "/{isu}/caldav-target")).Methods("DELETE")
+	router.Handle("/subscription", h.handlerFor("DELETE", "/subscription")).Methods("DELETE")
+	router.Handle("/meeting-slots", h.handlerFor("POST", "/meeting-slots")).Methods("POST")
+	router.Handle("/{isu}/caldav-target", h.handlerFor("GET", "/{isu}/caldav-target")).Methods("GET")
+	router.Handle("/{isu}/freebusy", h.handlerFor("GET", "/{isu}/freebusy")).Methods("GET")
 	router.Handle("/{isu}/ical", h.handlerFor("GET", "/{isu}/ical")).Methods("GET")
+	router.Handle("/{isu}/reminders", h.handlerFor("GET", "/{isu}/reminders")).Methods("GET")
 	router.Handle("/{isu}/schedule", h.handlerFor("GET", "/{isu}/schedule")).Methods("GET")
+	router.Handle("/{isu}/schedule/changes", h.handlerFor("GET", "/{isu}/schedule/changes")).Methods("GET")
+	router.Handle("/{isu}/settings", h.handlerFor("GET", "/{isu}/settings")).Methods("GET")
+	router.Handle("/status", h.handlerFor("GET", "/status")).Methods("GET")
+	router.Handle("/{isu}/ical", h.handlerFor("HEAD", "/{isu}/ical")).Methods("HEAD")
 	router.Handle("/health", h.handlerFor("GET", "/health")).Methods("GET")
+	router.Handle("/auth/logout", h.handlerFor("POST", "/auth/logout")).Methods("POST")
+	router.Handle("/auth/refresh", h.handlerFor("POST", "/auth/refresh")).Methods("POST")
+	router.Handle("/{isu}/feed/token", h.handlerFor("DELETE", "/{isu}/feed/token")).Methods("DELETE")
+	router.Handle("/{isu}/freebusy/token", h.handlerFor("DELETE", "/{isu}/freebusy/token")).Methods("DELETE")
+	router.Handle("/{isu}/feed/token", h.handlerFor("POST", "/{isu}/feed/token")).Methods("POST")
+	router.Handle("/{isu}/freebusy/token", h.handlerFor("POST", "/{isu}/freebusy/token")).Methods("POST")
+	router.Handle("/auth/start", h.handlerFor("GET", "/auth/start")).Methods("GET")
 	router.Handle("/subscribe", h.handlerFor("POST", "/subscribe")).Methods("POST")
+	router.Handle("/{isu}/caldav-target", h.handlerFor("PUT", "/{isu}/caldav-target")).Methods("PUT")
+	router.Handle("/{isu}/reminders", h.handlerFor("PUT", "/{isu}/reminders")).Methods("PUT")
+	router.Handle("/{isu}/settings", h.handlerFor("PUT", "/{isu}/settings")).Methods("PUT")
 
 	router.Handle("/swagger.json", h.SwaggerDocJSONHandler()).Methods("GET")
 	router.Handle("/docs", h.SwaggerDocUIHandler()).Methods("GET")
@@ -0,0 +1,35 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	apiCalDav "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/cal_dav"
+)
+
+func (h *Handler) HeadICalHandler(params apiCalDav.HeadICalParams, principal *entities.User) middleware.Responder {
+	calDav, err := h.usecases.GetICal.Execute(params.HTTPRequest.Context(), params.Isu)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "head ical", err)
+	}
+	if calDav == nil {
+		return apiCalDav.NewHeadICalNotFound()
+	}
+
+	lastModified := calDav.UpdatedAt.UTC().Format(http.TimeFormat)
+	if notModified(params.IfNoneMatch, params.IfModifiedSince, calDav.ETag, calDav.UpdatedAt) {
+		return apiCalDav.NewHeadICalNotModified().
+			WithETag(calDav.ETag).
+			WithLastModified(lastModified).
+			WithCacheControl(h.cacheControl)
+	}
+
+	return apiCalDav.NewHeadICalOK().
+		WithETag(calDav.ETag).
+		WithLastModified(lastModified).
+		WithCacheControl(h.cacheControl)
+}
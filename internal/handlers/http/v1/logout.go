@@ -0,0 +1,37 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiAuth "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/auth"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/sessions"
+)
+
+func (h *Handler) LogoutHandler(params apiAuth.LogoutParams) middleware.Responder {
+	if params.Body == nil || params.Body.RefreshToken == nil || *params.Body.RefreshToken == "" {
+		return apiAuth.NewLogoutBadRequest().WithPayload(&models.Error{
+			Error:   "BadRequest",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrRefreshTokenMissing),
+		})
+	}
+
+	all := params.Body.All != nil && *params.Body.All
+
+	err := h.usecases.Logout.Execute(params.HTTPRequest.Context(), *params.Body.RefreshToken, all)
+	if errors.Is(err, sessions.ErrInvalidSession) {
+		return apiAuth.NewLogoutUnauthorized().WithPayload(&models.Error{
+			Error:   "Unauthorized",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrInvalidSession),
+		})
+	}
+	if err != nil {
+		return h.fail(params.HTTPRequest, "logout", err)
+	}
+
+	return apiAuth.NewLogoutNoContent()
+}
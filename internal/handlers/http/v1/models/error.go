@@ -17,6 +17,13 @@ import (
 // swagger:model Error
 type Error struct {
 
+	// Stable machine-readable reason, e.g. invalid_credentials, token_expired, user_not_found,
+	// rate_limited, upstream_unavailable, login_expired, answer_missing, credentials_required,
+	// ical_not_found, schedule_not_found or internal. Absent on errors that do not have one yet.
+	//
+	// Example: upstream_unavailable
+	Code string `json:"code,omitempty"`
+
 	// error
 	// Example: Service Unavailable
 	Error string `json:"error,omitempty"`
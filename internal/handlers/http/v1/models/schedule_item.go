@@ -28,7 +28,10 @@ type ScheduleItem struct {
 
 	// lessons
 	// Required: true
-	Lessons []*ScheduleItemLessonsItems0 `json:"lessons"`
+	Lessons []*Lesson `json:"lessons"`
+
+	// week
+	Week *AcademicWeek `json:"week,omitempty"`
 }
 
 // Validate validates this schedule item
@@ -43,6 +46,10 @@ func (m *ScheduleItem) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateWeek(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -89,6 +96,25 @@ func (m *ScheduleItem) validateLessons(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ScheduleItem) validateWeek(formats strfmt.Registry) error {
+	if swag.IsZero(m.Week) { // not required
+		return nil
+	}
+
+	if m.Week != nil {
+		if err := m.Week.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("week")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("week")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this schedule item based on the context it is used
 func (m *ScheduleItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -97,6 +123,10 @@ func (m *ScheduleItem) ContextValidate(ctx context.Context, formats strfmt.Regis
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateWeek(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -128,227 +158,29 @@ func (m *ScheduleItem) contextValidateLessons(ctx context.Context, formats strfm
 	return nil
 }
 
-// MarshalBinary interface implementation
-func (m *ScheduleItem) MarshalBinary() ([]byte, error) {
-	if m == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(m)
-}
-
-// UnmarshalBinary interface implementation
-func (m *ScheduleItem) UnmarshalBinary(b []byte) error {
-	var res ScheduleItem
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*m = res
-	return nil
-}
-
-// ScheduleItemLessonsItems0 schedule item lessons items0
-//
-// swagger:model ScheduleItemLessonsItems0
-type ScheduleItemLessonsItems0 struct {
-
-	// building
-	// Example: Main
-	// Required: true
-	Building *string `json:"building"`
-
-	// format
-	// Example: Offline
-	// Required: true
-	Format *string `json:"format"`
-
-	// group
-	// Example: A1
-	// Required: true
-	Group *string `json:"group"`
-
-	// note
-	// Example: Bring calculator
-	Note string `json:"note,omitempty"`
-
-	// room
-	// Example: 101
-	// Required: true
-	Room *string `json:"room"`
-
-	// subject
-	// Example: Mathematics
-	// Required: true
-	Subject *string `json:"subject"`
-
-	// teacher name
-	// Example: Dr. Ivanov
-	// Required: true
-	TeacherName *string `json:"teacher_name"`
-
-	// time end
-	// Example: 2024-06-01T10:30:00Z
-	// Required: true
-	// Format: date-time
-	TimeEnd *strfmt.DateTime `json:"time_end"`
-
-	// time start
-	// Example: 2024-06-01T09:00:00Z
-	// Required: true
-	// Format: date-time
-	TimeStart *strfmt.DateTime `json:"time_start"`
-
-	// type
-	// Example: Lecture
-	// Required: true
-	Type *string `json:"type"`
-
-	// zoom url
-	// Example: https://zoom.us/j/123456789
-	ZoomURL string `json:"zoom_url,omitempty"`
-}
-
-// Validate validates this schedule item lessons items0
-func (m *ScheduleItemLessonsItems0) Validate(formats strfmt.Registry) error {
-	var res []error
-
-	if err := m.validateBuilding(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validateFormat(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validateGroup(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validateRoom(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validateSubject(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validateTeacherName(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validateTimeEnd(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validateTimeStart(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validateType(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateBuilding(formats strfmt.Registry) error {
-
-	if err := validate.Required("building", "body", m.Building); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateFormat(formats strfmt.Registry) error {
-
-	if err := validate.Required("format", "body", m.Format); err != nil {
-		return err
-	}
+func (m *ScheduleItem) contextValidateWeek(ctx context.Context, formats strfmt.Registry) error {
 
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateGroup(formats strfmt.Registry) error {
-
-	if err := validate.Required("group", "body", m.Group); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateRoom(formats strfmt.Registry) error {
-
-	if err := validate.Required("room", "body", m.Room); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateSubject(formats strfmt.Registry) error {
-
-	if err := validate.Required("subject", "body", m.Subject); err != nil {
-		return err
-	}
+	if m.Week != nil {
 
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateTeacherName(formats strfmt.Registry) error {
-
-	if err := validate.Required("teacher_name", "body", m.TeacherName); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateTimeEnd(formats strfmt.Registry) error {
-
-	if err := validate.Required("time_end", "body", m.TimeEnd); err != nil {
-		return err
-	}
-
-	if err := validate.FormatOf("time_end", "body", "date-time", m.TimeEnd.String(), formats); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateTimeStart(formats strfmt.Registry) error {
-
-	if err := validate.Required("time_start", "body", m.TimeStart); err != nil {
-		return err
-	}
-
-	if err := validate.FormatOf("time_start", "body", "date-time", m.TimeStart.String(), formats); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (m *ScheduleItemLessonsItems0) validateType(formats strfmt.Registry) error {
+		if swag.IsZero(m.Week) { // not required
+			return nil
+		}
 
-	if err := validate.Required("type", "body", m.Type); err != nil {
-		return err
+		if err := m.Week.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("week")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("week")
+			}
+			return err
+		}
 	}
 
 	return nil
 }
 
-// ContextValidate validates this schedule item lessons items0 based on context it is used
-func (m *ScheduleItemLessonsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
 // MarshalBinary interface implementation
-func (m *ScheduleItemLessonsItems0) MarshalBinary() ([]byte, error) {
+func (m *ScheduleItem) MarshalBinary() ([]byte, error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -356,8 +188,8 @@ func (m *ScheduleItemLessonsItems0) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (m *ScheduleItemLessonsItems0) UnmarshalBinary(b []byte) error {
-	var res ScheduleItemLessonsItems0
+func (m *ScheduleItem) UnmarshalBinary(b []byte) error {
+	var res ScheduleItem
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8,61 +8,39 @@ package models
 import (
 	"context"
 
-	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
-	"github.com/go-openapi/validate"
 )
 
-// SubscribeRequest subscribe request
+// SubscribeRequest Either isu and password to start a login, or challenge_id and its answer to resume one.
 //
 // swagger:model SubscribeRequest
 type SubscribeRequest struct {
 
+	// Accepts the ITMO ID terms of use, answers a terms challenge.
+	AcceptTerms bool `json:"accept_terms,omitempty"`
+
+	// ID of the challenge the login stopped at.
+	ChallengeID string `json:"challenge_id,omitempty"`
+
 	// isu
 	// Example: 123456789
-	// Required: true
-	Isu *int64 `json:"isu"`
+	Isu int64 `json:"isu,omitempty"`
+
+	// New ITMO ID password, answers an update_password challenge.
+	NewPassword string `json:"new_password,omitempty"`
+
+	// One-time code, answers an otp challenge.
+	// Example: 123456
+	Otp string `json:"otp,omitempty"`
 
 	// password
 	// Example: user_password
-	// Required: true
-	Password *string `json:"password"`
+	Password string `json:"password,omitempty"`
 }
 
 // Validate validates this subscribe request
 func (m *SubscribeRequest) Validate(formats strfmt.Registry) error {
-	var res []error
-
-	if err := m.validateIsu(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := m.validatePassword(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
-
-func (m *SubscribeRequest) validateIsu(formats strfmt.Registry) error {
-
-	if err := validate.Required("isu", "body", m.Isu); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (m *SubscribeRequest) validatePassword(formats strfmt.Registry) error {
-
-	if err := validate.Required("password", "body", m.Password); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -17,18 +18,90 @@ import (
 // swagger:model SubscribeResponse
 type SubscribeResponse struct {
 
+	// Path of the read-only CalDAV principal under the feed token, only returned on the first subscription.
+	// Example: /caldav/3q2-7wZ5h8cQ/
+	CaldavURL string `json:"caldav_url,omitempty"`
+
+	// Secret feed token, only returned on the first subscription.
+	FeedToken string `json:"feed_token,omitempty"`
+
+	// Path of the secret feed, only returned on the first subscription.
+	// Example: /feeds/3q2-7wZ5h8cQ.ics
+	FeedURL string `json:"feed_url,omitempty"`
+
 	// message
 	// Example: Subscription successful. iCal generated.
 	Message string `json:"message,omitempty"`
+
+	// session
+	Session *Session `json:"session,omitempty"`
 }
 
 // Validate validates this subscribe response
 func (m *SubscribeResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSession(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-// ContextValidate validates this subscribe response based on context it is used
+func (m *SubscribeResponse) validateSession(formats strfmt.Registry) error {
+	if swag.IsZero(m.Session) { // not required
+		return nil
+	}
+
+	if m.Session != nil {
+		if err := m.Session.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("session")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("session")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this subscribe response based on the context it is used
 func (m *SubscribeResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSession(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) contextValidateSession(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Session != nil {
+
+		if swag.IsZero(m.Session) { // not required
+			return nil
+		}
+
+		if err := m.Session.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("session")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("session")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,47 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiAuth "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/auth"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/sessions"
+)
+
+func (h *Handler) RefreshSessionHandler(params apiAuth.RefreshSessionParams) middleware.Responder {
+	if params.Body == nil || params.Body.RefreshToken == nil || *params.Body.RefreshToken == "" {
+		return apiAuth.NewRefreshSessionBadRequest().WithPayload(&models.Error{
+			Error:   "BadRequest",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrRefreshTokenMissing),
+		})
+	}
+
+	tokens, err := h.usecases.RefreshSession.Execute(params.HTTPRequest.Context(), *params.Body.RefreshToken)
+	if errors.Is(err, sessions.ErrInvalidSession) {
+		return apiAuth.NewRefreshSessionUnauthorized().WithPayload(&models.Error{
+			Error:   "Unauthorized",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrInvalidSession),
+		})
+	}
+	if err != nil {
+		return h.fail(params.HTTPRequest, "refresh session", err)
+	}
+
+	return apiAuth.NewRefreshSessionOK().WithPayload(sessionToDTO(tokens))
+}
+
+func sessionToDTO(tokens *entities.SessionTokens) *models.Session {
+	return &models.Session{
+		TokenType:             "Bearer",
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  strfmt.DateTime(tokens.AccessTokenExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: strfmt.DateTime(tokens.RefreshTokenExpiresAt),
+	}
+}
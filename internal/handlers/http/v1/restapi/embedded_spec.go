@@ -32,44 +32,32 @@ func init() {
   },
   "basePath": "/api/v1",
   "paths": {
-    "/health": {
+    "/auth/callback": {
       "get": {
-        "security": [],
-        "description": "Verifies the API is operational and returns its status.",
-        "tags": [
-          "System"
-        ],
-        "summary": "Health check endpoint.",
-        "operationId": "healthCheck",
-        "responses": {
-          "200": {
-            "description": "Service is healthy."
-          },
-          "503": {
-            "description": "Service is unhealthy.",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/subscribe": {
-      "post": {
-        "description": "Subscribes user by ISU and password, generates and stores iCal file.",
+        "description": "ITMO ID redirects the browser here after the login. Exchanges the code for ITMO ID tokens\nand subscribes the user like /subscribe does. A login can only be completed once, by the browser that started it.\n",
         "tags": [
-          "CalDav"
+          "Auth"
         ],
-        "summary": "Subscribe and generate iCal for user.",
-        "operationId": "subscribeSchedule",
+        "summary": "Complete a browser login.",
+        "operationId": "completeLogin",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SubscribeRequest"
-            }
+            "type": "string",
+            "description": "Authorization code issued by ITMO ID.",
+            "name": "code",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "State of the login passed to ITMO ID on start.",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Error reported by ITMO ID, e.g. when the user cancelled the login.",
+            "name": "error",
+            "in": "query"
           }
         ],
         "responses": {
@@ -80,7 +68,7 @@ func init() {
             }
           },
           "400": {
-            "description": "Bad request.",
+            "description": "Login failed, expired or was started in another browser.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -94,37 +82,36 @@ func init() {
         }
       }
     },
-    "/{isu}/ical": {
-      "get": {
-        "description": "Returns the iCalendar (.ics) file for the user with the given ISU.",
-        "produces": [
-          "text/calendar"
-        ],
+    "/auth/logout": {
+      "post": {
+        "description": "Revokes the session of the refresh token, or every session of its user if all is set.\nAccess tokens of revoked sessions stop working right away.\n",
         "tags": [
-          "CalDav"
+          "Auth"
         ],
-        "summary": "Get user's iCal file by ISU.",
-        "operationId": "getICal",
+        "summary": "Log out.",
+        "operationId": "logout",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "ISU of the user.",
-            "name": "isu",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LogoutRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "iCal file.",
+          "204": {
+            "description": "Session revoked."
+          },
+          "400": {
+            "description": "Bad request.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "Not found.",
+          "401": {
+            "description": "Invalid, expired or revoked refresh token.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -138,36 +125,39 @@ func init() {
         }
       }
     },
-    "/{isu}/schedule": {
-      "get": {
-        "description": "Returns the schedule for the user with the given ISU.",
+    "/auth/refresh": {
+      "post": {
+        "description": "Exchanges a refresh token for a new access and refresh token of the same session.\nRefresh tokens are single use, presenting a used one again revokes the session.\n",
         "tags": [
-          "Schedule"
+          "Auth"
         ],
-        "summary": "Get user's schedule by ISU.",
-        "operationId": "getSchedule",
+        "summary": "Refresh session tokens.",
+        "operationId": "refreshSession",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "ISU of the user.",
-            "name": "isu",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RefreshRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "User's schedule.",
+            "description": "New session tokens.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ScheduleItem"
-              }
+              "$ref": "#/definitions/Session"
             }
           },
-          "404": {
-            "description": "Not found.",
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid, expired or revoked refresh token.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -180,153 +170,34 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "Error": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string",
-          "example": "Service Unavailable"
-        },
-        "message": {
-          "type": "string",
-          "example": "The service is currently unavailable. Please try again later."
-        }
-      }
     },
-    "ScheduleItem": {
-      "type": "object",
-      "required": [
-        "date",
-        "lessons"
-      ],
-      "properties": {
-        "date": {
-          "type": "string",
-          "format": "date",
-          "example": "2024-06-01"
-        },
-        "lessons": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "subject",
-              "type",
-              "teacher_name",
-              "room",
-              "building",
-              "format",
-              "group",
-              "time_start",
-              "time_end"
-            ],
-            "properties": {
-              "building": {
-                "type": "string",
-                "example": "Main"
-              },
-              "format": {
-                "type": "string",
-                "example": "Offline"
-              },
-              "group": {
-                "type": "string",
-                "example": "A1"
-              },
-              "note": {
-                "type": "string",
-                "example": "Bring calculator"
-              },
-              "room": {
-                "type": "string",
-                "example": "101"
-              },
-              "subject": {
-                "type": "string",
-                "example": "Mathematics"
-              },
-              "teacher_name": {
-                "type": "string",
-                "example": "Dr. Ivanov"
-              },
-              "time_end": {
-                "type": "string",
-                "format": "date-time",
-                "example": "2024-06-01T10:30:00Z"
-              },
-              "time_start": {
-                "type": "string",
-                "format": "date-time",
-                "example": "2024-06-01T09:00:00Z"
-              },
-              "type": {
-                "type": "string",
-                "example": "Lecture"
-              },
-              "zoom_url": {
+    "/auth/start": {
+      "get": {
+        "description": "Redirects the browser to the ITMO ID login page. The user logs in there, ITMO ID sends them\nback to /auth/callback. Uses the authorization code flow with PKCE, the password never reaches the service.\n",
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Start a browser login at ITMO ID.",
+        "operationId": "startLogin",
+        "responses": {
+          "302": {
+            "description": "Redirect to ITMO ID.",
+            "headers": {
+              "Location": {
                 "type": "string",
-                "example": "https://zoom.us/j/123456789"
+                "description": "ITMO ID login page."
               }
             }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "SubscribeRequest": {
-      "type": "object",
-      "required": [
-        "isu",
-        "password"
-      ],
-      "properties": {
-        "isu": {
-          "type": "integer",
-          "format": "int64",
-          "example": 123456789
-        },
-        "password": {
-          "type": "string",
-          "example": "user_password"
-        }
-      }
-    },
-    "SubscribeResponse": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string",
-          "example": "Subscription successful. iCal generated."
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "JWT": {
-      "description": "JWT token for user authentication",
-      "type": "apiKey",
-      "name": "X-Auth-Token",
-      "in": "header"
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Itmo calendar sync service",
-    "title": "Itmo Calendar",
-    "version": "1.0.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
     "/health": {
       "get": {
         "security": [],
@@ -349,29 +220,29 @@ func init() {
         }
       }
     },
-    "/subscribe": {
+    "/meeting-slots": {
       "post": {
-        "description": "Subscribes user by ISU and password, generates and stores iCal file.",
+        "description": "Returns the slots within working hours where every participant is free, longest first.\nEach participant is identified by their free/busy share token, which is their consent to be included.\nOnly stored calendars are used, the ITMO schedule is not queried.\n",
         "tags": [
-          "CalDav"
+          "Schedule"
         ],
-        "summary": "Subscribe and generate iCal for user.",
-        "operationId": "subscribeSchedule",
+        "summary": "Find common free time of several students.",
+        "operationId": "findMeetingSlots",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SubscribeRequest"
+              "$ref": "#/definitions/MeetingSlotsRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Subscription successful.",
+            "description": "Common free slots.",
             "schema": {
-              "$ref": "#/definitions/SubscribeResponse"
+              "$ref": "#/definitions/MeetingSlots"
             }
           },
           "400": {
@@ -380,6 +251,18 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "A participant has not shared their availability.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "A participant has no schedule yet.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal server error.",
             "schema": {
@@ -389,37 +272,34 @@ func init() {
         }
       }
     },
-    "/{isu}/ical": {
+    "/status": {
       "get": {
-        "description": "Returns the iCalendar (.ics) file for the user with the given ISU.",
-        "produces": [
-          "text/calendar"
+        "security": [
+          {
+            "JWT": []
+          }
         ],
+        "description": "Reports whether the schedule of the user of the access token is synced. Users whose ITMO ID\ntokens expired (needs_reauth) or were rejected (revoked) are not synced until they subscribe again,\ntheir calendar keeps the last lessons and shows an all-day event asking to re-subscribe.\n",
         "tags": [
           "CalDav"
         ],
-        "summary": "Get user's iCal file by ISU.",
-        "operationId": "getICal",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "ISU of the user.",
-            "name": "isu",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "Get the sync state of the subscription.",
+        "operationId": "getSyncStatus",
         "responses": {
           "200": {
-            "description": "iCal file.",
+            "description": "Sync state.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/SyncStatus"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "404": {
-            "description": "Not found.",
+            "description": "Not subscribed.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -433,14 +313,3075 @@ func init() {
         }
       }
     },
-    "/{isu}/schedule": {
-      "get": {
-        "description": "Returns the schedule for the user with the given ISU.",
+    "/subscribe": {
+      "post": {
+        "description": "Subscribes user by ISU and password, generates and stores iCal file.\nDeprecated, the service should not see ITMO passwords: log in in the browser through /auth/start instead.\nThe first subscription issues a secret feed URL, later ones keep it, rotate it to get a new one.\nEvery subscription starts a session, its access token authorizes requests to the user's data.\nIf ITMO ID asks for more than the password, e.g. a one-time code, an expired password change\nor accepting terms, the login stops with a challenge. Send its ID with the answer to resume it.\n",
         "tags": [
-          "Schedule"
+          "CalDav"
         ],
-        "summary": "Get user's schedule by ISU.",
-        "operationId": "getSchedule",
+        "summary": "Subscribe and generate iCal for user.",
+        "operationId": "subscribeSchedule",
+        "deprecated": true,
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SubscribeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Subscription successful.",
+            "schema": {
+              "$ref": "#/definitions/SubscribeResponse"
+            }
+          },
+          "202": {
+            "description": "ITMO ID asks the user for more, answer the challenge to continue.",
+            "schema": {
+              "$ref": "#/definitions/LoginChallenge"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "ITMO ID rejected the ISU or password.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "ITMO throttles the requests, try again later.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "502": {
+            "description": "ITMO ID or the schedule API is unavailable.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/subscription": {
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Deletes the user of the access token with their ITMO ID tokens, calendars, settings and every\nother stored piece of data, and revokes the ITMO ID refresh token. All sessions end.\n",
+        "tags": [
+          "CalDav"
+        ],
+        "summary": "Unsubscribe and delete the account.",
+        "operationId": "deleteSubscription",
+        "responses": {
+          "204": {
+            "description": "Account deleted."
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not subscribed.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/caldav-target": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns the external CalDAV collection lessons of the user with the given ISU are pushed to.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Get user's CalDAV target.",
+        "operationId": "getCalDavTarget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User's CalDAV target.",
+            "schema": {
+              "$ref": "#/definitions/CalDavTarget"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Sets the external CalDAV collection lessons of the user with the given ISU are pushed to\non every refresh. Events edited on that server are not overwritten.\n",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Set user's CalDAV target.",
+        "operationId": "updateCalDavTarget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CalDavTargetInput"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated CalDAV target.",
+            "schema": {
+              "$ref": "#/definitions/CalDavTarget"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Stops pushing lessons of the user with the given ISU. Events already pushed are kept.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Remove user's CalDAV target.",
+        "operationId": "deleteCalDavTarget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "CalDAV target removed."
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/feed/token": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Issues a new secret feed token for the user with the given ISU, the feed is served at /feeds/{token}.ics.\nThe previous feed URL stops working. The token is only shown once.\n",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Rotate user's secret feed token.",
+        "operationId": "rotateFeedToken",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "New feed token.",
+            "schema": {
+              "$ref": "#/definitions/ShareToken"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Revokes the secret feed token of the user with the given ISU, the feed URL stops working.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Revoke user's secret feed token.",
+        "operationId": "revokeFeedToken",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Feed token revoked."
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/freebusy": {
+      "get": {
+        "description": "Returns the periods the user with the given ISU is busy in as a VFREEBUSY component,\nwithout subjects, teachers or rooms. Requires the user's free/busy share token.\n",
+        "produces": [
+          "application/json",
+          "text/calendar"
+        ],
+        "tags": [
+          "Schedule"
+        ],
+        "summary": "Get user's availability.",
+        "operationId": "getFreeBusy",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Free/busy share token of the user.",
+            "name": "token",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Start of the range, defaults to now.",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "End of the range, defaults to two weeks after the start. At most 92 days after it.",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "maximum": 240,
+            "type": "integer",
+            "default": 0,
+            "description": "Minutes to block before every lesson.",
+            "name": "padding_before",
+            "in": "query"
+          },
+          {
+            "maximum": 240,
+            "type": "integer",
+            "default": 0,
+            "description": "Minutes to block after every lesson.",
+            "name": "padding_after",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ics",
+              "json"
+            ],
+            "type": "string",
+            "default": "ics",
+            "description": "Response format.",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Busy periods, an iCalendar file with a VFREEBUSY component for the ics format.",
+            "schema": {
+              "$ref": "#/definitions/FreeBusy"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Missing or invalid share token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/freebusy/token": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Issues a new free/busy share token for the user with the given ISU, the previous one stops working.\nThe token is only shown once.\n",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Issue user's free/busy share token.",
+        "operationId": "rotateFreeBusyToken",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "New share token.",
+            "schema": {
+              "$ref": "#/definitions/ShareToken"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Revokes the free/busy share token of the user with the given ISU.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Revoke user's free/busy share token.",
+        "operationId": "revokeFreeBusyToken",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Share token revoked."
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/ical": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns the iCalendar (.ics) file for the user with the given ISU.\nAnswers 304 without a body when the cached feed is still current.\nOnly served when legacy ISU paths are enabled, use the secret feed URL /feeds/{token}.ics instead.\n",
+        "produces": [
+          "text/calendar"
+        ],
+        "tags": [
+          "CalDav"
+        ],
+        "summary": "Get user's iCal file by ISU.",
+        "operationId": "getICal",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "ETag of the cached feed.",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Last-Modified of the cached feed, ignored when If-None-Match is present.",
+            "name": "If-Modified-Since",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "iCal file.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator of the feed."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the feed content last changed."
+              }
+            }
+          },
+          "304": {
+            "description": "Feed not modified.",
+            "headers": {
+              "Cache-Control": {
+                "type": "string"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator of the feed."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the feed content last changed."
+              }
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "head": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Same as GET without the body.",
+        "tags": [
+          "CalDav"
+        ],
+        "summary": "Get validators of user's iCal file by ISU.",
+        "operationId": "headICal",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "ETag of the cached feed.",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Last-Modified of the cached feed, ignored when If-None-Match is present.",
+            "name": "If-Modified-Since",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Feed exists.",
+            "headers": {
+              "Cache-Control": {
+                "type": "string"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator of the feed."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the feed content last changed."
+              }
+            }
+          },
+          "304": {
+            "description": "Feed not modified.",
+            "headers": {
+              "Cache-Control": {
+                "type": "string"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator of the feed."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the feed content last changed."
+              }
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token."
+          },
+          "403": {
+            "description": "The access token belongs to another user."
+          },
+          "404": {
+            "description": "Not found."
+          },
+          "500": {
+            "description": "Internal server error."
+          }
+        }
+      }
+    },
+    "/{isu}/reminders": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns the alarm rules applied to lessons of the user with the given ISU.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Get user's reminder rules.",
+        "operationId": "getReminders",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User's reminder rules.",
+            "schema": {
+              "$ref": "#/definitions/Reminders"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Replaces the alarm rules of the user with the given ISU and regenerates the calendar.\nRules are checked in order and the first one matching a lesson wins.\n",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Replace user's reminder rules.",
+        "operationId": "updateReminders",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Reminders"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated reminder rules.",
+            "schema": {
+              "$ref": "#/definitions/Reminders"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/schedule": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns the schedule for the user with the given ISU.\nOnly served when legacy ISU paths are enabled, answers carry a Deprecation header.\n",
+        "tags": [
+          "Schedule"
+        ],
+        "summary": "Get user's schedule by ISU.",
+        "operationId": "getSchedule",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User's schedule.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ScheduleItem"
+              }
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/schedule/changes": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns lessons added, changed or removed since the sync token together with a new token.\nWithout a token every lesson of the current schedule is returned as added.\nAn expired token is answered with 410, the client has to resync without a token.\nOnly served when legacy ISU paths are enabled.\n",
+        "tags": [
+          "Schedule"
+        ],
+        "summary": "Get changes of user's schedule since a sync token.",
+        "operationId": "getScheduleChanges",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Token returned by the previous call.",
+            "name": "sync_token",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Schedule changes.",
+            "schema": {
+              "$ref": "#/definitions/ScheduleChanges"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "410": {
+            "description": "Sync token expired.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/settings": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns calendar preferences of the user with the given ISU.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Get user's calendar settings.",
+        "operationId": "getSettings",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User's settings.",
+            "schema": {
+              "$ref": "#/definitions/UserSettings"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Stores calendar preferences of the user with the given ISU and regenerates the calendar.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Update user's calendar settings.",
+        "operationId": "updateSettings",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UserSettings"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated settings.",
+            "schema": {
+              "$ref": "#/definitions/UserSettings"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AcademicWeek": {
+      "description": "Week of the academic calendar the day belongs to.",
+      "type": "object",
+      "required": [
+        "number",
+        "parity",
+        "period"
+      ],
+      "properties": {
+        "number": {
+          "description": "Week number counted from the semester start.",
+          "type": "integer",
+          "example": 7
+        },
+        "parity": {
+          "type": "string",
+          "enum": [
+            "odd",
+            "even"
+          ],
+          "example": "odd"
+        },
+        "period": {
+          "type": "string",
+          "enum": [
+            "study",
+            "holiday",
+            "session"
+          ],
+          "example": "study"
+        }
+      }
+    },
+    "BusyPeriod": {
+      "type": "object",
+      "required": [
+        "start",
+        "end"
+      ],
+      "properties": {
+        "end": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "start": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "CalDavTarget": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
+          "description": "URL of the calendar collection.",
+          "type": "string",
+          "example": "https://cloud.example.com/remote.php/dav/calendars/student/itmo/"
+        },
+        "username": {
+          "type": "string",
+          "example": "student"
+        }
+      }
+    },
+    "CalDavTargetInput": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "password": {
+          "description": "Password or app token, stored encrypted and never returned.",
+          "type": "string",
+          "format": "password"
+        },
+        "url": {
+          "description": "URL of the calendar collection.",
+          "type": "string",
+          "example": "https://cloud.example.com/remote.php/dav/calendars/student/itmo/"
+        },
+        "username": {
+          "type": "string",
+          "example": "student"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "description": "Stable machine-readable reason, e.g. invalid_credentials, token_expired, user_not_found,\nrate_limited, upstream_unavailable, login_expired, answer_missing, credentials_required,\nical_not_found, schedule_not_found or internal. Absent on errors that do not have one yet.\n",
+          "type": "string",
+          "example": "upstream_unavailable"
+        },
+        "error": {
+          "type": "string",
+          "example": "Service Unavailable"
+        },
+        "message": {
+          "type": "string",
+          "example": "The service is currently unavailable. Please try again later."
+        }
+      }
+    },
+    "EventTemplates": {
+      "description": "Go text/template strings evaluated against a lesson, empty for the default rendering.\nFields: .Subject, .Type, .TeacherName, .Room, .Building, .Format, .Group, .Note, .ZoomURL, .Start, .End.\nFunctions: short, abbr, truncate, upper, lower, trim, default.\n",
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "maxLength": 1000,
+          "example": "{{ .TeacherName }}\n{{ .Note }}"
+        },
+        "location": {
+          "type": "string",
+          "maxLength": 1000,
+          "example": "{{ .Room }}, {{ .Building }}"
+        },
+        "summary": {
+          "type": "string",
+          "maxLength": 1000,
+          "example": "{{ .Type }}: {{ .Subject }} ({{ short .TeacherName }})"
+        }
+      }
+    },
+    "FreeBusy": {
+      "type": "object",
+      "required": [
+        "from",
+        "to",
+        "busy"
+      ],
+      "properties": {
+        "busy": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BusyPeriod"
+          }
+        },
+        "from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "to": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "Lesson": {
+      "type": "object",
+      "required": [
+        "subject",
+        "type",
+        "teacher_name",
+        "room",
+        "building",
+        "format",
+        "group",
+        "time_start",
+        "time_end"
+      ],
+      "properties": {
+        "building": {
+          "type": "string",
+          "example": "Main"
+        },
+        "format": {
+          "type": "string",
+          "example": "Offline"
+        },
+        "group": {
+          "type": "string",
+          "example": "A1"
+        },
+        "note": {
+          "type": "string",
+          "example": "Bring calculator"
+        },
+        "room": {
+          "type": "string",
+          "example": "101"
+        },
+        "subject": {
+          "type": "string",
+          "example": "Mathematics"
+        },
+        "teacher_name": {
+          "type": "string",
+          "example": "Dr. Ivanov"
+        },
+        "time_end": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-06-01T10:30:00Z"
+        },
+        "time_start": {
+          "type": "string",
+          "format": "date-time",
+          "example": "2024-06-01T09:00:00Z"
+        },
+        "type": {
+          "type": "string",
+          "example": "Lecture"
+        },
+        "zoom_url": {
+          "type": "string",
+          "example": "https://zoom.us/j/123456789"
+        }
+      }
+    },
+    "LoginChallenge": {
+      "type": "object",
+      "properties": {
+        "challenge_id": {
+          "description": "Secret ID of the stopped login, valid until expires_at.",
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "message": {
+          "description": "Message shown by ITMO ID, e.g. why the previous answer was rejected.",
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "otp",
+            "update_password",
+            "terms"
+          ]
+        }
+      }
+    },
+    "LogoutRequest": {
+      "type": "object",
+      "required": [
+        "refresh_token"
+      ],
+      "properties": {
+        "all": {
+          "description": "Revoke every session of the user, not only this one.",
+          "type": "boolean",
+          "default": false
+        },
+        "refresh_token": {
+          "type": "string"
+        }
+      }
+    },
+    "MeetingParticipant": {
+      "type": "object",
+      "required": [
+        "token"
+      ],
+      "properties": {
+        "isu": {
+          "description": "Optional, checked against the owner of the token.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "token": {
+          "description": "Free/busy share token of the participant.",
+          "type": "string"
+        }
+      }
+    },
+    "MeetingSlot": {
+      "type": "object",
+      "required": [
+        "start",
+        "end",
+        "duration_minutes"
+      ],
+      "properties": {
+        "duration_minutes": {
+          "type": "integer"
+        },
+        "end": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "start": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "MeetingSlots": {
+      "type": "object",
+      "required": [
+        "slots"
+      ],
+      "properties": {
+        "slots": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MeetingSlot"
+          }
+        }
+      }
+    },
+    "MeetingSlotsRequest": {
+      "type": "object",
+      "required": [
+        "participants",
+        "from",
+        "to",
+        "min_duration"
+      ],
+      "properties": {
+        "day_end": {
+          "description": "End of working hours.",
+          "type": "string",
+          "default": "21:00",
+          "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]$|^24:00$"
+        },
+        "day_start": {
+          "description": "Start of working hours.",
+          "type": "string",
+          "default": "09:00",
+          "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]$"
+        },
+        "from": {
+          "description": "First day of the search.",
+          "type": "string",
+          "format": "date"
+        },
+        "limit": {
+          "type": "integer",
+          "default": 10,
+          "maximum": 50,
+          "minimum": 1
+        },
+        "min_duration": {
+          "description": "Shortest slot in minutes.",
+          "type": "integer",
+          "maximum": 720,
+          "minimum": 15
+        },
+        "participants": {
+          "type": "array",
+          "maxItems": 20,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/MeetingParticipant"
+          }
+        },
+        "time_zone": {
+          "description": "IANA time zone of working hours, the service default when empty.",
+          "type": "string",
+          "example": "Europe/Moscow"
+        },
+        "to": {
+          "description": "Last day of the search, at most 31 days after the first one.",
+          "type": "string",
+          "format": "date"
+        },
+        "travel_minutes": {
+          "description": "Minutes to keep free before and after every lesson held in a building.",
+          "type": "integer",
+          "default": 0,
+          "maximum": 120
+        }
+      }
+    },
+    "RefreshRequest": {
+      "type": "object",
+      "required": [
+        "refresh_token"
+      ],
+      "properties": {
+        "refresh_token": {
+          "type": "string"
+        }
+      }
+    },
+    "ReminderRule": {
+      "type": "object",
+      "properties": {
+        "format": {
+          "description": "Case-insensitive substring of the lesson format, empty matches any.",
+          "type": "string",
+          "example": ""
+        },
+        "lesson_type": {
+          "description": "Case-insensitive substring of the lesson type, empty matches any.",
+          "type": "string",
+          "example": "лек"
+        },
+        "minutes_before": {
+          "description": "Minutes before the lesson the alarm fires, null disables alarms for matching lessons.",
+          "type": "integer",
+          "maximum": 10080,
+          "x-nullable": true,
+          "example": 15
+        }
+      }
+    },
+    "Reminders": {
+      "type": "object",
+      "required": [
+        "rules"
+      ],
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReminderRule"
+          }
+        }
+      }
+    },
+    "ScheduleChange": {
+      "type": "object",
+      "required": [
+        "uid",
+        "type",
+        "date"
+      ],
+      "properties": {
+        "date": {
+          "type": "string",
+          "format": "date",
+          "example": "2024-06-01"
+        },
+        "lesson": {
+          "$ref": "#/definitions/Lesson"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "added",
+            "changed",
+            "removed"
+          ],
+          "example": "changed"
+        },
+        "uid": {
+          "description": "iCalendar UID of the lesson.",
+          "type": "string",
+          "example": "0f1e2d3c4b5a69788796a5b4c3d2e1f0@itmo-calendar"
+        }
+      }
+    },
+    "ScheduleChanges": {
+      "type": "object",
+      "required": [
+        "sync_token",
+        "changes"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ScheduleChange"
+          }
+        },
+        "sync_token": {
+          "description": "Opaque token to pass on the next call.",
+          "type": "string",
+          "example": "1042"
+        }
+      }
+    },
+    "ScheduleItem": {
+      "type": "object",
+      "required": [
+        "date",
+        "lessons"
+      ],
+      "properties": {
+        "date": {
+          "type": "string",
+          "format": "date",
+          "example": "2024-06-01"
+        },
+        "lessons": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Lesson"
+          }
+        },
+        "week": {
+          "$ref": "#/definitions/AcademicWeek"
+        }
+      }
+    },
+    "Session": {
+      "type": "object",
+      "properties": {
+        "access_token": {
+          "description": "Short-lived token to send in the Authorization header.",
+          "type": "string"
+        },
+        "access_token_expires_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "refresh_token": {
+          "description": "Single use token to get new tokens once the access token expires.",
+          "type": "string"
+        },
+        "refresh_token_expires_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "token_type": {
+          "type": "string",
+          "example": "Bearer"
+        }
+      }
+    },
+    "ShareToken": {
+      "type": "object",
+      "required": [
+        "token"
+      ],
+      "properties": {
+        "token": {
+          "description": "Secret token, shown only once.",
+          "type": "string"
+        }
+      }
+    },
+    "SubscribeRequest": {
+      "description": "Either isu and password to start a login, or challenge_id and its answer to resume one.",
+      "type": "object",
+      "properties": {
+        "accept_terms": {
+          "description": "Accepts the ITMO ID terms of use, answers a terms challenge.",
+          "type": "boolean"
+        },
+        "challenge_id": {
+          "description": "ID of the challenge the login stopped at.",
+          "type": "string"
+        },
+        "isu": {
+          "type": "integer",
+          "format": "int64",
+          "example": 123456789
+        },
+        "new_password": {
+          "description": "New ITMO ID password, answers an update_password challenge.",
+          "type": "string"
+        },
+        "otp": {
+          "description": "One-time code, answers an otp challenge.",
+          "type": "string",
+          "example": "123456"
+        },
+        "password": {
+          "type": "string",
+          "example": "user_password"
+        }
+      }
+    },
+    "SubscribeResponse": {
+      "type": "object",
+      "properties": {
+        "caldav_url": {
+          "description": "Path of the read-only CalDAV principal under the feed token, only returned on the first subscription.",
+          "type": "string",
+          "example": "/caldav/3q2-7wZ5h8cQ/"
+        },
+        "feed_token": {
+          "description": "Secret feed token, only returned on the first subscription.",
+          "type": "string"
+        },
+        "feed_url": {
+          "description": "Path of the secret feed, only returned on the first subscription.",
+          "type": "string",
+          "example": "/feeds/3q2-7wZ5h8cQ.ics"
+        },
+        "message": {
+          "type": "string",
+          "example": "Subscription successful. iCal generated."
+        },
+        "session": {
+          "$ref": "#/definitions/Session"
+        }
+      }
+    },
+    "SyncStatus": {
+      "type": "object",
+      "properties": {
+        "last_failed_at": {
+          "description": "Last time fetching the schedule failed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "last_synced_at": {
+          "description": "Last time the schedule was fetched from ITMO.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "resubscribe_url": {
+          "description": "Where to log in again, only set when the user has to.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "active",
+            "needs_reauth",
+            "revoked"
+          ]
+        },
+        "status_changed_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "tokens_expire_at": {
+          "description": "When the ITMO ID login expires unless it is refreshed before.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "UserSettings": {
+      "type": "object",
+      "properties": {
+        "compress_recurrence": {
+          "description": "Emit weekly and bi-weekly lessons as recurring events with RRULE and EXDATE.",
+          "type": "boolean",
+          "example": false
+        },
+        "locale": {
+          "description": "Language of calendar labels and lesson types, empty for the default.",
+          "type": "string",
+          "enum": [
+            "ru",
+            "en"
+          ],
+          "example": "en"
+        },
+        "templates": {
+          "$ref": "#/definitions/EventTemplates"
+        },
+        "time_zone": {
+          "description": "IANA time zone of calendar events.",
+          "type": "string",
+          "example": "Europe/Moscow"
+        },
+        "week_markers": {
+          "description": "Add an all-day event naming the academic week on every Monday.",
+          "type": "boolean",
+          "example": false
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "JWT": {
+      "description": "Access token of a session as \"Bearer \u003ctoken\u003e\", issued by /auth/callback, /subscribe and /auth/refresh.\nIt only grants access to the data of the user it was issued to.\n",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Itmo calendar sync service",
+    "title": "Itmo Calendar",
+    "version": "1.0.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/auth/callback": {
+      "get": {
+        "description": "ITMO ID redirects the browser here after the login. Exchanges the code for ITMO ID tokens\nand subscribes the user like /subscribe does. A login can only be completed once, by the browser that started it.\n",
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Complete a browser login.",
+        "operationId": "completeLogin",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Authorization code issued by ITMO ID.",
+            "name": "code",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "State of the login passed to ITMO ID on start.",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Error reported by ITMO ID, e.g. when the user cancelled the login.",
+            "name": "error",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Subscription successful.",
+            "schema": {
+              "$ref": "#/definitions/SubscribeResponse"
+            }
+          },
+          "400": {
+            "description": "Login failed, expired or was started in another browser.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/auth/logout": {
+      "post": {
+        "description": "Revokes the session of the refresh token, or every session of its user if all is set.\nAccess tokens of revoked sessions stop working right away.\n",
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Log out.",
+        "operationId": "logout",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LogoutRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Session revoked."
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid, expired or revoked refresh token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/auth/refresh": {
+      "post": {
+        "description": "Exchanges a refresh token for a new access and refresh token of the same session.\nRefresh tokens are single use, presenting a used one again revokes the session.\n",
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Refresh session tokens.",
+        "operationId": "refreshSession",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RefreshRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "New session tokens.",
+            "schema": {
+              "$ref": "#/definitions/Session"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid, expired or revoked refresh token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/auth/start": {
+      "get": {
+        "description": "Redirects the browser to the ITMO ID login page. The user logs in there, ITMO ID sends them\nback to /auth/callback. Uses the authorization code flow with PKCE, the password never reaches the service.\n",
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Start a browser login at ITMO ID.",
+        "operationId": "startLogin",
+        "responses": {
+          "302": {
+            "description": "Redirect to ITMO ID.",
+            "headers": {
+              "Location": {
+                "type": "string",
+                "description": "ITMO ID login page."
+              }
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "security": [],
+        "description": "Verifies the API is operational and returns its status.",
+        "tags": [
+          "System"
+        ],
+        "summary": "Health check endpoint.",
+        "operationId": "healthCheck",
+        "responses": {
+          "200": {
+            "description": "Service is healthy."
+          },
+          "503": {
+            "description": "Service is unhealthy.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/meeting-slots": {
+      "post": {
+        "description": "Returns the slots within working hours where every participant is free, longest first.\nEach participant is identified by their free/busy share token, which is their consent to be included.\nOnly stored calendars are used, the ITMO schedule is not queried.\n",
+        "tags": [
+          "Schedule"
+        ],
+        "summary": "Find common free time of several students.",
+        "operationId": "findMeetingSlots",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MeetingSlotsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Common free slots.",
+            "schema": {
+              "$ref": "#/definitions/MeetingSlots"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "A participant has not shared their availability.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "A participant has no schedule yet.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/status": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Reports whether the schedule of the user of the access token is synced. Users whose ITMO ID\ntokens expired (needs_reauth) or were rejected (revoked) are not synced until they subscribe again,\ntheir calendar keeps the last lessons and shows an all-day event asking to re-subscribe.\n",
+        "tags": [
+          "CalDav"
+        ],
+        "summary": "Get the sync state of the subscription.",
+        "operationId": "getSyncStatus",
+        "responses": {
+          "200": {
+            "description": "Sync state.",
+            "schema": {
+              "$ref": "#/definitions/SyncStatus"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not subscribed.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/subscribe": {
+      "post": {
+        "description": "Subscribes user by ISU and password, generates and stores iCal file.\nDeprecated, the service should not see ITMO passwords: log in in the browser through /auth/start instead.\nThe first subscription issues a secret feed URL, later ones keep it, rotate it to get a new one.\nEvery subscription starts a session, its access token authorizes requests to the user's data.\nIf ITMO ID asks for more than the password, e.g. a one-time code, an expired password change\nor accepting terms, the login stops with a challenge. Send its ID with the answer to resume it.\n",
+        "tags": [
+          "CalDav"
+        ],
+        "summary": "Subscribe and generate iCal for user.",
+        "operationId": "subscribeSchedule",
+        "deprecated": true,
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SubscribeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Subscription successful.",
+            "schema": {
+              "$ref": "#/definitions/SubscribeResponse"
+            }
+          },
+          "202": {
+            "description": "ITMO ID asks the user for more, answer the challenge to continue.",
+            "schema": {
+              "$ref": "#/definitions/LoginChallenge"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "ITMO ID rejected the ISU or password.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "ITMO throttles the requests, try again later.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "502": {
+            "description": "ITMO ID or the schedule API is unavailable.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/subscription": {
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Deletes the user of the access token with their ITMO ID tokens, calendars, settings and every\nother stored piece of data, and revokes the ITMO ID refresh token. All sessions end.\n",
+        "tags": [
+          "CalDav"
+        ],
+        "summary": "Unsubscribe and delete the account.",
+        "operationId": "deleteSubscription",
+        "responses": {
+          "204": {
+            "description": "Account deleted."
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not subscribed.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/caldav-target": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns the external CalDAV collection lessons of the user with the given ISU are pushed to.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Get user's CalDAV target.",
+        "operationId": "getCalDavTarget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User's CalDAV target.",
+            "schema": {
+              "$ref": "#/definitions/CalDavTarget"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Sets the external CalDAV collection lessons of the user with the given ISU are pushed to\non every refresh. Events edited on that server are not overwritten.\n",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Set user's CalDAV target.",
+        "operationId": "updateCalDavTarget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CalDavTargetInput"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated CalDAV target.",
+            "schema": {
+              "$ref": "#/definitions/CalDavTarget"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Stops pushing lessons of the user with the given ISU. Events already pushed are kept.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Remove user's CalDAV target.",
+        "operationId": "deleteCalDavTarget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "CalDAV target removed."
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/feed/token": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Issues a new secret feed token for the user with the given ISU, the feed is served at /feeds/{token}.ics.\nThe previous feed URL stops working. The token is only shown once.\n",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Rotate user's secret feed token.",
+        "operationId": "rotateFeedToken",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "New feed token.",
+            "schema": {
+              "$ref": "#/definitions/ShareToken"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Revokes the secret feed token of the user with the given ISU, the feed URL stops working.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Revoke user's secret feed token.",
+        "operationId": "revokeFeedToken",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Feed token revoked."
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/freebusy": {
+      "get": {
+        "description": "Returns the periods the user with the given ISU is busy in as a VFREEBUSY component,\nwithout subjects, teachers or rooms. Requires the user's free/busy share token.\n",
+        "produces": [
+          "application/json",
+          "text/calendar"
+        ],
+        "tags": [
+          "Schedule"
+        ],
+        "summary": "Get user's availability.",
+        "operationId": "getFreeBusy",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Free/busy share token of the user.",
+            "name": "token",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Start of the range, defaults to now.",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "End of the range, defaults to two weeks after the start. At most 92 days after it.",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "maximum": 240,
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "Minutes to block before every lesson.",
+            "name": "padding_before",
+            "in": "query"
+          },
+          {
+            "maximum": 240,
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "Minutes to block after every lesson.",
+            "name": "padding_after",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ics",
+              "json"
+            ],
+            "type": "string",
+            "default": "ics",
+            "description": "Response format.",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Busy periods, an iCalendar file with a VFREEBUSY component for the ics format.",
+            "schema": {
+              "$ref": "#/definitions/FreeBusy"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Missing or invalid share token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/freebusy/token": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Issues a new free/busy share token for the user with the given ISU, the previous one stops working.\nThe token is only shown once.\n",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Issue user's free/busy share token.",
+        "operationId": "rotateFreeBusyToken",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "New share token.",
+            "schema": {
+              "$ref": "#/definitions/ShareToken"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Revokes the free/busy share token of the user with the given ISU.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Revoke user's free/busy share token.",
+        "operationId": "revokeFreeBusyToken",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Share token revoked."
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/ical": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns the iCalendar (.ics) file for the user with the given ISU.\nAnswers 304 without a body when the cached feed is still current.\nOnly served when legacy ISU paths are enabled, use the secret feed URL /feeds/{token}.ics instead.\n",
+        "produces": [
+          "text/calendar"
+        ],
+        "tags": [
+          "CalDav"
+        ],
+        "summary": "Get user's iCal file by ISU.",
+        "operationId": "getICal",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "ETag of the cached feed.",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Last-Modified of the cached feed, ignored when If-None-Match is present.",
+            "name": "If-Modified-Since",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "iCal file.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator of the feed."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the feed content last changed."
+              }
+            }
+          },
+          "304": {
+            "description": "Feed not modified.",
+            "headers": {
+              "Cache-Control": {
+                "type": "string"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator of the feed."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the feed content last changed."
+              }
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "head": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Same as GET without the body.",
+        "tags": [
+          "CalDav"
+        ],
+        "summary": "Get validators of user's iCal file by ISU.",
+        "operationId": "headICal",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "ETag of the cached feed.",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Last-Modified of the cached feed, ignored when If-None-Match is present.",
+            "name": "If-Modified-Since",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Feed exists.",
+            "headers": {
+              "Cache-Control": {
+                "type": "string"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator of the feed."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the feed content last changed."
+              }
+            }
+          },
+          "304": {
+            "description": "Feed not modified.",
+            "headers": {
+              "Cache-Control": {
+                "type": "string"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator of the feed."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the feed content last changed."
+              }
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token."
+          },
+          "403": {
+            "description": "The access token belongs to another user."
+          },
+          "404": {
+            "description": "Not found."
+          },
+          "500": {
+            "description": "Internal server error."
+          }
+        }
+      }
+    },
+    "/{isu}/reminders": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns the alarm rules applied to lessons of the user with the given ISU.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Get user's reminder rules.",
+        "operationId": "getReminders",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User's reminder rules.",
+            "schema": {
+              "$ref": "#/definitions/Reminders"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Replaces the alarm rules of the user with the given ISU and regenerates the calendar.\nRules are checked in order and the first one matching a lesson wins.\n",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Replace user's reminder rules.",
+        "operationId": "updateReminders",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Reminders"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated reminder rules.",
+            "schema": {
+              "$ref": "#/definitions/Reminders"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/schedule": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns the schedule for the user with the given ISU.\nOnly served when legacy ISU paths are enabled, answers carry a Deprecation header.\n",
+        "tags": [
+          "Schedule"
+        ],
+        "summary": "Get user's schedule by ISU.",
+        "operationId": "getSchedule",
+        "deprecated": true,
         "parameters": [
           {
             "type": "integer",
@@ -461,6 +3402,214 @@ func init() {
               }
             }
           },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/schedule/changes": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns lessons added, changed or removed since the sync token together with a new token.\nWithout a token every lesson of the current schedule is returned as added.\nAn expired token is answered with 410, the client has to resync without a token.\nOnly served when legacy ISU paths are enabled.\n",
+        "tags": [
+          "Schedule"
+        ],
+        "summary": "Get changes of user's schedule since a sync token.",
+        "operationId": "getScheduleChanges",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Token returned by the previous call.",
+            "name": "sync_token",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Schedule changes.",
+            "schema": {
+              "$ref": "#/definitions/ScheduleChanges"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "410": {
+            "description": "Sync token expired.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/{isu}/settings": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Returns calendar preferences of the user with the given ISU.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Get user's calendar settings.",
+        "operationId": "getSettings",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User's settings.",
+            "schema": {
+              "$ref": "#/definitions/UserSettings"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "description": "Stores calendar preferences of the user with the given ISU and regenerates the calendar.",
+        "tags": [
+          "Settings"
+        ],
+        "summary": "Update user's calendar settings.",
+        "operationId": "updateSettings",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "ISU of the user.",
+            "name": "isu",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UserSettings"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated settings.",
+            "schema": {
+              "$ref": "#/definitions/UserSettings"
+            }
+          },
+          "400": {
+            "description": "Bad request.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Missing, invalid or revoked access token.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The access token belongs to another user.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "404": {
             "description": "Not found.",
             "schema": {
@@ -475,12 +3624,106 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
+    }
+  },
+  "definitions": {
+    "AcademicWeek": {
+      "description": "Week of the academic calendar the day belongs to.",
+      "type": "object",
+      "required": [
+        "number",
+        "parity",
+        "period"
+      ],
+      "properties": {
+        "number": {
+          "description": "Week number counted from the semester start.",
+          "type": "integer",
+          "example": 7
+        },
+        "parity": {
+          "type": "string",
+          "enum": [
+            "odd",
+            "even"
+          ],
+          "example": "odd"
+        },
+        "period": {
+          "type": "string",
+          "enum": [
+            "study",
+            "holiday",
+            "session"
+          ],
+          "example": "study"
+        }
+      }
+    },
+    "BusyPeriod": {
+      "type": "object",
+      "required": [
+        "start",
+        "end"
+      ],
+      "properties": {
+        "end": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "start": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "CalDavTarget": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "url": {
+          "description": "URL of the calendar collection.",
+          "type": "string",
+          "example": "https://cloud.example.com/remote.php/dav/calendars/student/itmo/"
+        },
+        "username": {
+          "type": "string",
+          "example": "student"
+        }
+      }
+    },
+    "CalDavTargetInput": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "password": {
+          "description": "Password or app token, stored encrypted and never returned.",
+          "type": "string",
+          "format": "password"
+        },
+        "url": {
+          "description": "URL of the calendar collection.",
+          "type": "string",
+          "example": "https://cloud.example.com/remote.php/dav/calendars/student/itmo/"
+        },
+        "username": {
+          "type": "string",
+          "example": "student"
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "properties": {
+        "code": {
+          "description": "Stable machine-readable reason, e.g. invalid_credentials, token_expired, user_not_found,\nrate_limited, upstream_unavailable, login_expired, answer_missing, credentials_required,\nical_not_found, schedule_not_found or internal. Absent on errors that do not have one yet.\n",
+          "type": "string",
+          "example": "upstream_unavailable"
+        },
         "error": {
           "type": "string",
           "example": "Service Unavailable"
@@ -491,27 +3734,52 @@ func init() {
         }
       }
     },
-    "ScheduleItem": {
+    "EventTemplates": {
+      "description": "Go text/template strings evaluated against a lesson, empty for the default rendering.\nFields: .Subject, .Type, .TeacherName, .Room, .Building, .Format, .Group, .Note, .ZoomURL, .Start, .End.\nFunctions: short, abbr, truncate, upper, lower, trim, default.\n",
       "type": "object",
-      "required": [
-        "date",
-        "lessons"
-      ],
       "properties": {
-        "date": {
+        "description": {
           "type": "string",
-          "format": "date",
-          "example": "2024-06-01"
+          "maxLength": 1000,
+          "example": "{{ .TeacherName }}\n{{ .Note }}"
         },
-        "lessons": {
+        "location": {
+          "type": "string",
+          "maxLength": 1000,
+          "example": "{{ .Room }}, {{ .Building }}"
+        },
+        "summary": {
+          "type": "string",
+          "maxLength": 1000,
+          "example": "{{ .Type }}: {{ .Subject }} ({{ short .TeacherName }})"
+        }
+      }
+    },
+    "FreeBusy": {
+      "type": "object",
+      "required": [
+        "from",
+        "to",
+        "busy"
+      ],
+      "properties": {
+        "busy": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ScheduleItemLessonsItems0"
+            "$ref": "#/definitions/BusyPeriod"
           }
+        },
+        "from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "to": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "ScheduleItemLessonsItems0": {
+    "Lesson": {
       "type": "object",
       "required": [
         "subject",
@@ -573,18 +3841,350 @@ func init() {
         }
       }
     },
-    "SubscribeRequest": {
+    "LoginChallenge": {
+      "type": "object",
+      "properties": {
+        "challenge_id": {
+          "description": "Secret ID of the stopped login, valid until expires_at.",
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "message": {
+          "description": "Message shown by ITMO ID, e.g. why the previous answer was rejected.",
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "otp",
+            "update_password",
+            "terms"
+          ]
+        }
+      }
+    },
+    "LogoutRequest": {
+      "type": "object",
+      "required": [
+        "refresh_token"
+      ],
+      "properties": {
+        "all": {
+          "description": "Revoke every session of the user, not only this one.",
+          "type": "boolean",
+          "default": false
+        },
+        "refresh_token": {
+          "type": "string"
+        }
+      }
+    },
+    "MeetingParticipant": {
+      "type": "object",
+      "required": [
+        "token"
+      ],
+      "properties": {
+        "isu": {
+          "description": "Optional, checked against the owner of the token.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "token": {
+          "description": "Free/busy share token of the participant.",
+          "type": "string"
+        }
+      }
+    },
+    "MeetingSlot": {
+      "type": "object",
+      "required": [
+        "start",
+        "end",
+        "duration_minutes"
+      ],
+      "properties": {
+        "duration_minutes": {
+          "type": "integer"
+        },
+        "end": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "start": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "MeetingSlots": {
+      "type": "object",
+      "required": [
+        "slots"
+      ],
+      "properties": {
+        "slots": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MeetingSlot"
+          }
+        }
+      }
+    },
+    "MeetingSlotsRequest": {
+      "type": "object",
+      "required": [
+        "participants",
+        "from",
+        "to",
+        "min_duration"
+      ],
+      "properties": {
+        "day_end": {
+          "description": "End of working hours.",
+          "type": "string",
+          "default": "21:00",
+          "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]$|^24:00$"
+        },
+        "day_start": {
+          "description": "Start of working hours.",
+          "type": "string",
+          "default": "09:00",
+          "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]$"
+        },
+        "from": {
+          "description": "First day of the search.",
+          "type": "string",
+          "format": "date"
+        },
+        "limit": {
+          "type": "integer",
+          "default": 10,
+          "maximum": 50,
+          "minimum": 1
+        },
+        "min_duration": {
+          "description": "Shortest slot in minutes.",
+          "type": "integer",
+          "maximum": 720,
+          "minimum": 15
+        },
+        "participants": {
+          "type": "array",
+          "maxItems": 20,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/MeetingParticipant"
+          }
+        },
+        "time_zone": {
+          "description": "IANA time zone of working hours, the service default when empty.",
+          "type": "string",
+          "example": "Europe/Moscow"
+        },
+        "to": {
+          "description": "Last day of the search, at most 31 days after the first one.",
+          "type": "string",
+          "format": "date"
+        },
+        "travel_minutes": {
+          "description": "Minutes to keep free before and after every lesson held in a building.",
+          "type": "integer",
+          "default": 0,
+          "maximum": 120,
+          "minimum": 0
+        }
+      }
+    },
+    "RefreshRequest": {
+      "type": "object",
+      "required": [
+        "refresh_token"
+      ],
+      "properties": {
+        "refresh_token": {
+          "type": "string"
+        }
+      }
+    },
+    "ReminderRule": {
+      "type": "object",
+      "properties": {
+        "format": {
+          "description": "Case-insensitive substring of the lesson format, empty matches any.",
+          "type": "string",
+          "example": ""
+        },
+        "lesson_type": {
+          "description": "Case-insensitive substring of the lesson type, empty matches any.",
+          "type": "string",
+          "example": "лек"
+        },
+        "minutes_before": {
+          "description": "Minutes before the lesson the alarm fires, null disables alarms for matching lessons.",
+          "type": "integer",
+          "maximum": 10080,
+          "minimum": 0,
+          "x-nullable": true,
+          "example": 15
+        }
+      }
+    },
+    "Reminders": {
+      "type": "object",
+      "required": [
+        "rules"
+      ],
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReminderRule"
+          }
+        }
+      }
+    },
+    "ScheduleChange": {
+      "type": "object",
+      "required": [
+        "uid",
+        "type",
+        "date"
+      ],
+      "properties": {
+        "date": {
+          "type": "string",
+          "format": "date",
+          "example": "2024-06-01"
+        },
+        "lesson": {
+          "$ref": "#/definitions/Lesson"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "added",
+            "changed",
+            "removed"
+          ],
+          "example": "changed"
+        },
+        "uid": {
+          "description": "iCalendar UID of the lesson.",
+          "type": "string",
+          "example": "0f1e2d3c4b5a69788796a5b4c3d2e1f0@itmo-calendar"
+        }
+      }
+    },
+    "ScheduleChanges": {
+      "type": "object",
+      "required": [
+        "sync_token",
+        "changes"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ScheduleChange"
+          }
+        },
+        "sync_token": {
+          "description": "Opaque token to pass on the next call.",
+          "type": "string",
+          "example": "1042"
+        }
+      }
+    },
+    "ScheduleItem": {
+      "type": "object",
+      "required": [
+        "date",
+        "lessons"
+      ],
+      "properties": {
+        "date": {
+          "type": "string",
+          "format": "date",
+          "example": "2024-06-01"
+        },
+        "lessons": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Lesson"
+          }
+        },
+        "week": {
+          "$ref": "#/definitions/AcademicWeek"
+        }
+      }
+    },
+    "Session": {
+      "type": "object",
+      "properties": {
+        "access_token": {
+          "description": "Short-lived token to send in the Authorization header.",
+          "type": "string"
+        },
+        "access_token_expires_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "refresh_token": {
+          "description": "Single use token to get new tokens once the access token expires.",
+          "type": "string"
+        },
+        "refresh_token_expires_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "token_type": {
+          "type": "string",
+          "example": "Bearer"
+        }
+      }
+    },
+    "ShareToken": {
       "type": "object",
       "required": [
-        "isu",
-        "password"
+        "token"
       ],
       "properties": {
+        "token": {
+          "description": "Secret token, shown only once.",
+          "type": "string"
+        }
+      }
+    },
+    "SubscribeRequest": {
+      "description": "Either isu and password to start a login, or challenge_id and its answer to resume one.",
+      "type": "object",
+      "properties": {
+        "accept_terms": {
+          "description": "Accepts the ITMO ID terms of use, answers a terms challenge.",
+          "type": "boolean"
+        },
+        "challenge_id": {
+          "description": "ID of the challenge the login stopped at.",
+          "type": "string"
+        },
         "isu": {
           "type": "integer",
           "format": "int64",
           "example": 123456789
         },
+        "new_password": {
+          "description": "New ITMO ID password, answers an update_password challenge.",
+          "type": "string"
+        },
+        "otp": {
+          "description": "One-time code, answers an otp challenge.",
+          "type": "string",
+          "example": "123456"
+        },
         "password": {
           "type": "string",
           "example": "user_password"
@@ -594,18 +4194,107 @@ func init() {
     "SubscribeResponse": {
       "type": "object",
       "properties": {
+        "caldav_url": {
+          "description": "Path of the read-only CalDAV principal under the feed token, only returned on the first subscription.",
+          "type": "string",
+          "example": "/caldav/3q2-7wZ5h8cQ/"
+        },
+        "feed_token": {
+          "description": "Secret feed token, only returned on the first subscription.",
+          "type": "string"
+        },
+        "feed_url": {
+          "description": "Path of the secret feed, only returned on the first subscription.",
+          "type": "string",
+          "example": "/feeds/3q2-7wZ5h8cQ.ics"
+        },
         "message": {
           "type": "string",
           "example": "Subscription successful. iCal generated."
+        },
+        "session": {
+          "$ref": "#/definitions/Session"
+        }
+      }
+    },
+    "SyncStatus": {
+      "type": "object",
+      "properties": {
+        "last_failed_at": {
+          "description": "Last time fetching the schedule failed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "last_synced_at": {
+          "description": "Last time the schedule was fetched from ITMO.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "resubscribe_url": {
+          "description": "Where to log in again, only set when the user has to.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "active",
+            "needs_reauth",
+            "revoked"
+          ]
+        },
+        "status_changed_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "tokens_expire_at": {
+          "description": "When the ITMO ID login expires unless it is refreshed before.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "UserSettings": {
+      "type": "object",
+      "properties": {
+        "compress_recurrence": {
+          "description": "Emit weekly and bi-weekly lessons as recurring events with RRULE and EXDATE.",
+          "type": "boolean",
+          "example": false
+        },
+        "locale": {
+          "description": "Language of calendar labels and lesson types, empty for the default.",
+          "type": "string",
+          "enum": [
+            "ru",
+            "en"
+          ],
+          "example": "en"
+        },
+        "templates": {
+          "$ref": "#/definitions/EventTemplates"
+        },
+        "time_zone": {
+          "description": "IANA time zone of calendar events.",
+          "type": "string",
+          "example": "Europe/Moscow"
+        },
+        "week_markers": {
+          "description": "Add an all-day event naming the academic week on every Monday.",
+          "type": "boolean",
+          "example": false
         }
       }
     }
   },
   "securityDefinitions": {
     "JWT": {
-      "description": "JWT token for user authentication",
+      "description": "Access token of a session as \"Bearer \u003ctoken\u003e\", issued by /auth/callback, /subscribe and /auth/refresh.\nIt only grants access to the data of the user it was issued to.\n",
       "type": "apiKey",
-      "name": "X-Auth-Token",
+      "name": "Authorization",
       "in": "header"
     }
   }
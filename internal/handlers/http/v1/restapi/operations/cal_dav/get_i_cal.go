@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
 )
 
 // GetICalHandlerFunc turns a function with the right signature into a get i cal handler
-type GetICalHandlerFunc func(GetICalParams) middleware.Responder
+type GetICalHandlerFunc func(GetICalParams, *entities.User) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn GetICalHandlerFunc) Handle(params GetICalParams) middleware.Responder {
-	return fn(params)
+func (fn GetICalHandlerFunc) Handle(params GetICalParams, principal *entities.User) middleware.Responder {
+	return fn(params, principal)
 }
 
 // GetICalHandler interface for that can handle valid get i cal params
 type GetICalHandler interface {
-	Handle(GetICalParams) middleware.Responder
+	Handle(GetICalParams, *entities.User) middleware.Responder
 }
 
 // NewGetICal creates a new http.Handler for the get i cal operation
@@ -35,6 +37,8 @@ func NewGetICal(ctx *middleware.Context, handler GetICalHandler) *GetICal {
 Get user's iCal file by ISU.
 
 Returns the iCalendar (.ics) file for the user with the given ISU.
+Answers 304 without a body when the cached feed is still current.
+Only served when legacy ISU paths are enabled, use the secret feed URL /feeds/{token}.ics instead.
 */
 type GetICal struct {
 	Context *middleware.Context
@@ -47,12 +51,25 @@ func (o *GetICal) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewGetICalParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *entities.User
+	if uprinc != nil {
+		principal = uprinc.(*entities.User) // this is really a entities.User, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -31,6 +31,14 @@ type GetICalParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Last-Modified of the cached feed, ignored when If-None-Match is present.
+	  In: header
+	*/
+	IfModifiedSince *string
+	/*ETag of the cached feed.
+	  In: header
+	*/
+	IfNoneMatch *string
 	/*ISU of the user.
 	  Required: true
 	  In: path
@@ -47,6 +55,14 @@ func (o *GetICalParams) BindRequest(r *http.Request, route *middleware.MatchedRo
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfModifiedSince(r.Header[http.CanonicalHeaderKey("If-Modified-Since")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindIfNoneMatch(r.Header[http.CanonicalHeaderKey("If-None-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rIsu, rhkIsu, _ := route.Params.GetOK("isu")
 	if err := o.bindIsu(rIsu, rhkIsu, route.Formats); err != nil {
 		res = append(res, err)
@@ -57,6 +73,40 @@ func (o *GetICalParams) BindRequest(r *http.Request, route *middleware.MatchedRo
 	return nil
 }
 
+// bindIfModifiedSince binds and validates parameter IfModifiedSince from header.
+func (o *GetICalParams) bindIfModifiedSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfModifiedSince = &raw
+
+	return nil
+}
+
+// bindIfNoneMatch binds and validates parameter IfNoneMatch from header.
+func (o *GetICalParams) bindIfNoneMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfNoneMatch = &raw
+
+	return nil
+}
+
 // bindIsu binds and validates parameter Isu from path.
 func (o *GetICalParams) bindIsu(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
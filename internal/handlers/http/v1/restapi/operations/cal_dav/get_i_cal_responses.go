@@ -23,6 +23,18 @@ GetICalOK iCal file.
 swagger:response getICalOK
 */
 type GetICalOK struct {
+	/*
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*Strong validator of the feed.
+
+	 */
+	ETag string `json:"ETag"`
+	/*Time the feed content last changed.
+
+	 */
+	LastModified string `json:"Last-Modified"`
 
 	/*
 	  In: Body
@@ -36,6 +48,39 @@ func NewGetICalOK() *GetICalOK {
 	return &GetICalOK{}
 }
 
+// WithCacheControl adds the cacheControl to the get i cal o k response
+func (o *GetICalOK) WithCacheControl(cacheControl string) *GetICalOK {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get i cal o k response
+func (o *GetICalOK) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithETag adds the eTag to the get i cal o k response
+func (o *GetICalOK) WithETag(eTag string) *GetICalOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get i cal o k response
+func (o *GetICalOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithLastModified adds the lastModified to the get i cal o k response
+func (o *GetICalOK) WithLastModified(lastModified string) *GetICalOK {
+	o.LastModified = lastModified
+	return o
+}
+
+// SetLastModified sets the lastModified to the get i cal o k response
+func (o *GetICalOK) SetLastModified(lastModified string) {
+	o.LastModified = lastModified
+}
+
 // WithPayload adds the payload to the get i cal o k response
 func (o *GetICalOK) WithPayload(payload io.ReadCloser) *GetICalOK {
 	o.Payload = payload
@@ -50,6 +95,27 @@ func (o *GetICalOK) SetPayload(payload io.ReadCloser) {
 // WriteResponse to the client
 func (o *GetICalOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	// response header Last-Modified
+
+	lastModified := o.LastModified
+	if lastModified != "" {
+		rw.Header().Set("Last-Modified", lastModified)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if err := producer.Produce(rw, payload); err != nil {
@@ -57,6 +123,187 @@ func (o *GetICalOK) WriteResponse(rw http.ResponseWriter, producer runtime.Produ
 	}
 }
 
+// GetICalNotModifiedCode is the HTTP code returned for type GetICalNotModified
+const GetICalNotModifiedCode int = 304
+
+/*
+GetICalNotModified Feed not modified.
+
+swagger:response getICalNotModified
+*/
+type GetICalNotModified struct {
+	/*
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*Strong validator of the feed.
+
+	 */
+	ETag string `json:"ETag"`
+	/*Time the feed content last changed.
+
+	 */
+	LastModified string `json:"Last-Modified"`
+}
+
+// NewGetICalNotModified creates GetICalNotModified with default headers values
+func NewGetICalNotModified() *GetICalNotModified {
+
+	return &GetICalNotModified{}
+}
+
+// WithCacheControl adds the cacheControl to the get i cal not modified response
+func (o *GetICalNotModified) WithCacheControl(cacheControl string) *GetICalNotModified {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get i cal not modified response
+func (o *GetICalNotModified) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithETag adds the eTag to the get i cal not modified response
+func (o *GetICalNotModified) WithETag(eTag string) *GetICalNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get i cal not modified response
+func (o *GetICalNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithLastModified adds the lastModified to the get i cal not modified response
+func (o *GetICalNotModified) WithLastModified(lastModified string) *GetICalNotModified {
+	o.LastModified = lastModified
+	return o
+}
+
+// SetLastModified sets the lastModified to the get i cal not modified response
+func (o *GetICalNotModified) SetLastModified(lastModified string) {
+	o.LastModified = lastModified
+}
+
+// WriteResponse to the client
+func (o *GetICalNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	// response header Last-Modified
+
+	lastModified := o.LastModified
+	if lastModified != "" {
+		rw.Header().Set("Last-Modified", lastModified)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
+// GetICalUnauthorizedCode is the HTTP code returned for type GetICalUnauthorized
+const GetICalUnauthorizedCode int = 401
+
+/*
+GetICalUnauthorized Missing, invalid or revoked access token.
+
+swagger:response getICalUnauthorized
+*/
+type GetICalUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetICalUnauthorized creates GetICalUnauthorized with default headers values
+func NewGetICalUnauthorized() *GetICalUnauthorized {
+
+	return &GetICalUnauthorized{}
+}
+
+// WithPayload adds the payload to the get i cal unauthorized response
+func (o *GetICalUnauthorized) WithPayload(payload *models.Error) *GetICalUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get i cal unauthorized response
+func (o *GetICalUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetICalUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetICalForbiddenCode is the HTTP code returned for type GetICalForbidden
+const GetICalForbiddenCode int = 403
+
+/*
+GetICalForbidden The access token belongs to another user.
+
+swagger:response getICalForbidden
+*/
+type GetICalForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetICalForbidden creates GetICalForbidden with default headers values
+func NewGetICalForbidden() *GetICalForbidden {
+
+	return &GetICalForbidden{}
+}
+
+// WithPayload adds the payload to the get i cal forbidden response
+func (o *GetICalForbidden) WithPayload(payload *models.Error) *GetICalForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get i cal forbidden response
+func (o *GetICalForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetICalForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetICalNotFoundCode is the HTTP code returned for type GetICalNotFound
 const GetICalNotFoundCode int = 404
 
@@ -35,6 +35,11 @@ func NewSubscribeSchedule(ctx *middleware.Context, handler SubscribeScheduleHand
 Subscribe and generate iCal for user.
 
 Subscribes user by ISU and password, generates and stores iCal file.
+Deprecated, the service should not see ITMO passwords: log in in the browser through /auth/start instead.
+The first subscription issues a secret feed URL, later ones keep it, rotate it to get a new one.
+Every subscription starts a session, its access token authorizes requests to the user's data.
+If ITMO ID asks for more than the password, e.g. a one-time code, an expired password change
+or accepting terms, the login stops with a challenge. Send its ID with the answer to resume it.
 */
 type SubscribeSchedule struct {
 	Context *middleware.Context
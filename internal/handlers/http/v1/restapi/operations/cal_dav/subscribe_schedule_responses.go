@@ -58,6 +58,51 @@ func (o *SubscribeScheduleOK) WriteResponse(rw http.ResponseWriter, producer run
 	}
 }
 
+// SubscribeScheduleAcceptedCode is the HTTP code returned for type SubscribeScheduleAccepted
+const SubscribeScheduleAcceptedCode int = 202
+
+/*
+SubscribeScheduleAccepted ITMO ID asks the user for more, answer the challenge to continue.
+
+swagger:response subscribeScheduleAccepted
+*/
+type SubscribeScheduleAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LoginChallenge `json:"body,omitempty"`
+}
+
+// NewSubscribeScheduleAccepted creates SubscribeScheduleAccepted with default headers values
+func NewSubscribeScheduleAccepted() *SubscribeScheduleAccepted {
+
+	return &SubscribeScheduleAccepted{}
+}
+
+// WithPayload adds the payload to the subscribe schedule accepted response
+func (o *SubscribeScheduleAccepted) WithPayload(payload *models.LoginChallenge) *SubscribeScheduleAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the subscribe schedule accepted response
+func (o *SubscribeScheduleAccepted) SetPayload(payload *models.LoginChallenge) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubscribeScheduleAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // SubscribeScheduleBadRequestCode is the HTTP code returned for type SubscribeScheduleBadRequest
 const SubscribeScheduleBadRequestCode int = 400
 
@@ -103,6 +148,96 @@ func (o *SubscribeScheduleBadRequest) WriteResponse(rw http.ResponseWriter, prod
 	}
 }
 
+// SubscribeScheduleUnauthorizedCode is the HTTP code returned for type SubscribeScheduleUnauthorized
+const SubscribeScheduleUnauthorizedCode int = 401
+
+/*
+SubscribeScheduleUnauthorized ITMO ID rejected the ISU or password.
+
+swagger:response subscribeScheduleUnauthorized
+*/
+type SubscribeScheduleUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSubscribeScheduleUnauthorized creates SubscribeScheduleUnauthorized with default headers values
+func NewSubscribeScheduleUnauthorized() *SubscribeScheduleUnauthorized {
+
+	return &SubscribeScheduleUnauthorized{}
+}
+
+// WithPayload adds the payload to the subscribe schedule unauthorized response
+func (o *SubscribeScheduleUnauthorized) WithPayload(payload *models.Error) *SubscribeScheduleUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the subscribe schedule unauthorized response
+func (o *SubscribeScheduleUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubscribeScheduleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SubscribeScheduleTooManyRequestsCode is the HTTP code returned for type SubscribeScheduleTooManyRequests
+const SubscribeScheduleTooManyRequestsCode int = 429
+
+/*
+SubscribeScheduleTooManyRequests ITMO throttles the requests, try again later.
+
+swagger:response subscribeScheduleTooManyRequests
+*/
+type SubscribeScheduleTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSubscribeScheduleTooManyRequests creates SubscribeScheduleTooManyRequests with default headers values
+func NewSubscribeScheduleTooManyRequests() *SubscribeScheduleTooManyRequests {
+
+	return &SubscribeScheduleTooManyRequests{}
+}
+
+// WithPayload adds the payload to the subscribe schedule too many requests response
+func (o *SubscribeScheduleTooManyRequests) WithPayload(payload *models.Error) *SubscribeScheduleTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the subscribe schedule too many requests response
+func (o *SubscribeScheduleTooManyRequests) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubscribeScheduleTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // SubscribeScheduleInternalServerErrorCode is the HTTP code returned for type SubscribeScheduleInternalServerError
 const SubscribeScheduleInternalServerErrorCode int = 500
 
@@ -147,3 +282,48 @@ func (o *SubscribeScheduleInternalServerError) WriteResponse(rw http.ResponseWri
 		}
 	}
 }
+
+// SubscribeScheduleBadGatewayCode is the HTTP code returned for type SubscribeScheduleBadGateway
+const SubscribeScheduleBadGatewayCode int = 502
+
+/*
+SubscribeScheduleBadGateway ITMO ID or the schedule API is unavailable.
+
+swagger:response subscribeScheduleBadGateway
+*/
+type SubscribeScheduleBadGateway struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSubscribeScheduleBadGateway creates SubscribeScheduleBadGateway with default headers values
+func NewSubscribeScheduleBadGateway() *SubscribeScheduleBadGateway {
+
+	return &SubscribeScheduleBadGateway{}
+}
+
+// WithPayload adds the payload to the subscribe schedule bad gateway response
+func (o *SubscribeScheduleBadGateway) WithPayload(payload *models.Error) *SubscribeScheduleBadGateway {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the subscribe schedule bad gateway response
+func (o *SubscribeScheduleBadGateway) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubscribeScheduleBadGateway) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(502)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
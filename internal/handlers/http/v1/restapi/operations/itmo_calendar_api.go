@@ -20,8 +20,11 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/auth"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/cal_dav"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/schedule"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/system"
 	"go.uber.org/zap"
 )
@@ -51,18 +54,88 @@ func NewItmoCalendarAPI(spec *loads.Document) *ItmoCalendarAPI {
 			return errors.NotImplemented("textCalendar producer has not yet been implemented")
 		}),
 
-		CalDavGetICalHandler: cal_dav.GetICalHandlerFunc(func(params cal_dav.GetICalParams) middleware.Responder {
+		AuthCompleteLoginHandler: auth.CompleteLoginHandlerFunc(func(params auth.CompleteLoginParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.CompleteLogin has not yet been implemented")
+		}),
+		SettingsDeleteCalDavTargetHandler: settings.DeleteCalDavTargetHandlerFunc(func(params settings.DeleteCalDavTargetParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.DeleteCalDavTarget has not yet been implemented")
+		}),
+		CalDavDeleteSubscriptionHandler: cal_dav.DeleteSubscriptionHandlerFunc(func(params cal_dav.DeleteSubscriptionParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation cal_dav.DeleteSubscription has not yet been implemented")
+		}),
+		ScheduleFindMeetingSlotsHandler: schedule.FindMeetingSlotsHandlerFunc(func(params schedule.FindMeetingSlotsParams) middleware.Responder {
+			return middleware.NotImplemented("operation schedule.FindMeetingSlots has not yet been implemented")
+		}),
+		SettingsGetCalDavTargetHandler: settings.GetCalDavTargetHandlerFunc(func(params settings.GetCalDavTargetParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.GetCalDavTarget has not yet been implemented")
+		}),
+		ScheduleGetFreeBusyHandler: schedule.GetFreeBusyHandlerFunc(func(params schedule.GetFreeBusyParams) middleware.Responder {
+			return middleware.NotImplemented("operation schedule.GetFreeBusy has not yet been implemented")
+		}),
+		CalDavGetICalHandler: cal_dav.GetICalHandlerFunc(func(params cal_dav.GetICalParams, principal *entities.User) middleware.Responder {
 			return middleware.NotImplemented("operation cal_dav.GetICal has not yet been implemented")
 		}),
-		ScheduleGetScheduleHandler: schedule.GetScheduleHandlerFunc(func(params schedule.GetScheduleParams) middleware.Responder {
+		SettingsGetRemindersHandler: settings.GetRemindersHandlerFunc(func(params settings.GetRemindersParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.GetReminders has not yet been implemented")
+		}),
+		ScheduleGetScheduleHandler: schedule.GetScheduleHandlerFunc(func(params schedule.GetScheduleParams, principal *entities.User) middleware.Responder {
 			return middleware.NotImplemented("operation schedule.GetSchedule has not yet been implemented")
 		}),
+		ScheduleGetScheduleChangesHandler: schedule.GetScheduleChangesHandlerFunc(func(params schedule.GetScheduleChangesParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation schedule.GetScheduleChanges has not yet been implemented")
+		}),
+		SettingsGetSettingsHandler: settings.GetSettingsHandlerFunc(func(params settings.GetSettingsParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.GetSettings has not yet been implemented")
+		}),
+		CalDavGetSyncStatusHandler: cal_dav.GetSyncStatusHandlerFunc(func(params cal_dav.GetSyncStatusParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation cal_dav.GetSyncStatus has not yet been implemented")
+		}),
+		CalDavHeadICalHandler: cal_dav.HeadICalHandlerFunc(func(params cal_dav.HeadICalParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation cal_dav.HeadICal has not yet been implemented")
+		}),
 		SystemHealthCheckHandler: system.HealthCheckHandlerFunc(func(params system.HealthCheckParams) middleware.Responder {
 			return middleware.NotImplemented("operation system.HealthCheck has not yet been implemented")
 		}),
+		AuthLogoutHandler: auth.LogoutHandlerFunc(func(params auth.LogoutParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.Logout has not yet been implemented")
+		}),
+		AuthRefreshSessionHandler: auth.RefreshSessionHandlerFunc(func(params auth.RefreshSessionParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.RefreshSession has not yet been implemented")
+		}),
+		SettingsRevokeFeedTokenHandler: settings.RevokeFeedTokenHandlerFunc(func(params settings.RevokeFeedTokenParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.RevokeFeedToken has not yet been implemented")
+		}),
+		SettingsRevokeFreeBusyTokenHandler: settings.RevokeFreeBusyTokenHandlerFunc(func(params settings.RevokeFreeBusyTokenParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.RevokeFreeBusyToken has not yet been implemented")
+		}),
+		SettingsRotateFeedTokenHandler: settings.RotateFeedTokenHandlerFunc(func(params settings.RotateFeedTokenParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.RotateFeedToken has not yet been implemented")
+		}),
+		SettingsRotateFreeBusyTokenHandler: settings.RotateFreeBusyTokenHandlerFunc(func(params settings.RotateFreeBusyTokenParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.RotateFreeBusyToken has not yet been implemented")
+		}),
+		AuthStartLoginHandler: auth.StartLoginHandlerFunc(func(params auth.StartLoginParams) middleware.Responder {
+			return middleware.NotImplemented("operation auth.StartLogin has not yet been implemented")
+		}),
 		CalDavSubscribeScheduleHandler: cal_dav.SubscribeScheduleHandlerFunc(func(params cal_dav.SubscribeScheduleParams) middleware.Responder {
 			return middleware.NotImplemented("operation cal_dav.SubscribeSchedule has not yet been implemented")
 		}),
+		SettingsUpdateCalDavTargetHandler: settings.UpdateCalDavTargetHandlerFunc(func(params settings.UpdateCalDavTargetParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.UpdateCalDavTarget has not yet been implemented")
+		}),
+		SettingsUpdateRemindersHandler: settings.UpdateRemindersHandlerFunc(func(params settings.UpdateRemindersParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.UpdateReminders has not yet been implemented")
+		}),
+		SettingsUpdateSettingsHandler: settings.UpdateSettingsHandlerFunc(func(params settings.UpdateSettingsParams, principal *entities.User) middleware.Responder {
+			return middleware.NotImplemented("operation settings.UpdateSettings has not yet been implemented")
+		}),
+
+		// Applies when the "Authorization" header is set
+		JWTAuth: func(token string) (*entities.User, error) {
+			return nil, errors.NotImplemented("api key auth (JWT) Authorization from header param [Authorization] has not yet been implemented")
+		},
+		// default authorizer is authorized meaning no requests are blocked
+		APIAuthorizer: security.Authorized(),
 	}
 }
 
@@ -102,14 +175,63 @@ type ItmoCalendarAPI struct {
 	//   - text/calendar
 	TextCalendarProducer runtime.Producer
 
+	// JWTAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key Authorization provided in the header
+	JWTAuth func(string) (*entities.User, error)
+
+	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
+	APIAuthorizer runtime.Authorizer
+
+	// AuthCompleteLoginHandler sets the operation handler for the complete login operation
+	AuthCompleteLoginHandler auth.CompleteLoginHandler
+	// SettingsDeleteCalDavTargetHandler sets the operation handler for the delete cal dav target operation
+	SettingsDeleteCalDavTargetHandler settings.DeleteCalDavTargetHandler
+	// CalDavDeleteSubscriptionHandler sets the operation handler for the delete subscription operation
+	CalDavDeleteSubscriptionHandler cal_dav.DeleteSubscriptionHandler
+	// ScheduleFindMeetingSlotsHandler sets the operation handler for the find meeting slots operation
+	ScheduleFindMeetingSlotsHandler schedule.FindMeetingSlotsHandler
+	// SettingsGetCalDavTargetHandler sets the operation handler for the get cal dav target operation
+	SettingsGetCalDavTargetHandler settings.GetCalDavTargetHandler
+	// ScheduleGetFreeBusyHandler sets the operation handler for the get free busy operation
+	ScheduleGetFreeBusyHandler schedule.GetFreeBusyHandler
 	// CalDavGetICalHandler sets the operation handler for the get i cal operation
 	CalDavGetICalHandler cal_dav.GetICalHandler
+	// SettingsGetRemindersHandler sets the operation handler for the get reminders operation
+	SettingsGetRemindersHandler settings.GetRemindersHandler
 	// ScheduleGetScheduleHandler sets the operation handler for the get schedule operation
 	ScheduleGetScheduleHandler schedule.GetScheduleHandler
+	// ScheduleGetScheduleChangesHandler sets the operation handler for the get schedule changes operation
+	ScheduleGetScheduleChangesHandler schedule.GetScheduleChangesHandler
+	// SettingsGetSettingsHandler sets the operation handler for the get settings operation
+	SettingsGetSettingsHandler settings.GetSettingsHandler
+	// CalDavGetSyncStatusHandler sets the operation handler for the get sync status operation
+	CalDavGetSyncStatusHandler cal_dav.GetSyncStatusHandler
+	// CalDavHeadICalHandler sets the operation handler for the head i cal operation
+	CalDavHeadICalHandler cal_dav.HeadICalHandler
 	// SystemHealthCheckHandler sets the operation handler for the health check operation
 	SystemHealthCheckHandler system.HealthCheckHandler
+	// AuthLogoutHandler sets the operation handler for the logout operation
+	AuthLogoutHandler auth.LogoutHandler
+	// AuthRefreshSessionHandler sets the operation handler for the refresh session operation
+	AuthRefreshSessionHandler auth.RefreshSessionHandler
+	// SettingsRevokeFeedTokenHandler sets the operation handler for the revoke feed token operation
+	SettingsRevokeFeedTokenHandler settings.RevokeFeedTokenHandler
+	// SettingsRevokeFreeBusyTokenHandler sets the operation handler for the revoke free busy token operation
+	SettingsRevokeFreeBusyTokenHandler settings.RevokeFreeBusyTokenHandler
+	// SettingsRotateFeedTokenHandler sets the operation handler for the rotate feed token operation
+	SettingsRotateFeedTokenHandler settings.RotateFeedTokenHandler
+	// SettingsRotateFreeBusyTokenHandler sets the operation handler for the rotate free busy token operation
+	SettingsRotateFreeBusyTokenHandler settings.RotateFreeBusyTokenHandler
+	// AuthStartLoginHandler sets the operation handler for the start login operation
+	AuthStartLoginHandler auth.StartLoginHandler
 	// CalDavSubscribeScheduleHandler sets the operation handler for the subscribe schedule operation
 	CalDavSubscribeScheduleHandler cal_dav.SubscribeScheduleHandler
+	// SettingsUpdateCalDavTargetHandler sets the operation handler for the update cal dav target operation
+	SettingsUpdateCalDavTargetHandler settings.UpdateCalDavTargetHandler
+	// SettingsUpdateRemindersHandler sets the operation handler for the update reminders operation
+	SettingsUpdateRemindersHandler settings.UpdateRemindersHandler
+	// SettingsUpdateSettingsHandler sets the operation handler for the update settings operation
+	SettingsUpdateSettingsHandler settings.UpdateSettingsHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -190,18 +312,85 @@ func (o *ItmoCalendarAPI) Validate() error {
 		unregistered = append(unregistered, "TextCalendarProducer")
 	}
 
+	if o.JWTAuth == nil {
+		unregistered = append(unregistered, "AuthorizationAuth")
+	}
+
+	if o.AuthCompleteLoginHandler == nil {
+		unregistered = append(unregistered, "auth.CompleteLoginHandler")
+	}
+	if o.SettingsDeleteCalDavTargetHandler == nil {
+		unregistered = append(unregistered, "settings.DeleteCalDavTargetHandler")
+	}
+	if o.CalDavDeleteSubscriptionHandler == nil {
+		unregistered = append(unregistered, "cal_dav.DeleteSubscriptionHandler")
+	}
+	if o.ScheduleFindMeetingSlotsHandler == nil {
+		unregistered = append(unregistered, "schedule.FindMeetingSlotsHandler")
+	}
+	if o.SettingsGetCalDavTargetHandler == nil {
+		unregistered = append(unregistered, "settings.GetCalDavTargetHandler")
+	}
+	if o.ScheduleGetFreeBusyHandler == nil {
+		unregistered = append(unregistered, "schedule.GetFreeBusyHandler")
+	}
 	if o.CalDavGetICalHandler == nil {
 		unregistered = append(unregistered, "cal_dav.GetICalHandler")
 	}
+	if o.SettingsGetRemindersHandler == nil {
+		unregistered = append(unregistered, "settings.GetRemindersHandler")
+	}
 	if o.ScheduleGetScheduleHandler == nil {
 		unregistered = append(unregistered, "schedule.GetScheduleHandler")
 	}
+	if o.ScheduleGetScheduleChangesHandler == nil {
+		unregistered = append(unregistered, "schedule.GetScheduleChangesHandler")
+	}
+	if o.SettingsGetSettingsHandler == nil {
+		unregistered = append(unregistered, "settings.GetSettingsHandler")
+	}
+	if o.CalDavGetSyncStatusHandler == nil {
+		unregistered = append(unregistered, "cal_dav.GetSyncStatusHandler")
+	}
+	if o.CalDavHeadICalHandler == nil {
+		unregistered = append(unregistered, "cal_dav.HeadICalHandler")
+	}
 	if o.SystemHealthCheckHandler == nil {
 		unregistered = append(unregistered, "system.HealthCheckHandler")
 	}
+	if o.AuthLogoutHandler == nil {
+		unregistered = append(unregistered, "auth.LogoutHandler")
+	}
+	if o.AuthRefreshSessionHandler == nil {
+		unregistered = append(unregistered, "auth.RefreshSessionHandler")
+	}
+	if o.SettingsRevokeFeedTokenHandler == nil {
+		unregistered = append(unregistered, "settings.RevokeFeedTokenHandler")
+	}
+	if o.SettingsRevokeFreeBusyTokenHandler == nil {
+		unregistered = append(unregistered, "settings.RevokeFreeBusyTokenHandler")
+	}
+	if o.SettingsRotateFeedTokenHandler == nil {
+		unregistered = append(unregistered, "settings.RotateFeedTokenHandler")
+	}
+	if o.SettingsRotateFreeBusyTokenHandler == nil {
+		unregistered = append(unregistered, "settings.RotateFreeBusyTokenHandler")
+	}
+	if o.AuthStartLoginHandler == nil {
+		unregistered = append(unregistered, "auth.StartLoginHandler")
+	}
 	if o.CalDavSubscribeScheduleHandler == nil {
 		unregistered = append(unregistered, "cal_dav.SubscribeScheduleHandler")
 	}
+	if o.SettingsUpdateCalDavTargetHandler == nil {
+		unregistered = append(unregistered, "settings.UpdateCalDavTargetHandler")
+	}
+	if o.SettingsUpdateRemindersHandler == nil {
+		unregistered = append(unregistered, "settings.UpdateRemindersHandler")
+	}
+	if o.SettingsUpdateSettingsHandler == nil {
+		unregistered = append(unregistered, "settings.UpdateSettingsHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -217,12 +406,23 @@ func (o *ItmoCalendarAPI) ServeErrorFor(operationID string) func(http.ResponseWr
 
 // AuthenticatorsFor gets the authenticators for the specified security schemes
 func (o *ItmoCalendarAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
-	return nil
+	result := make(map[string]runtime.Authenticator)
+	for name := range schemes {
+		switch name {
+		case "JWT":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, func(token string) (interface{}, error) {
+				return o.JWTAuth(token)
+			})
+
+		}
+	}
+	return result
 }
 
 // Authorizer returns the registered authorizer
 func (o *ItmoCalendarAPI) Authorizer() runtime.Authorizer {
-	return nil
+	return o.APIAuthorizer
 }
 
 // ConsumersFor gets the consumers for the specified media types.
@@ -292,6 +492,30 @@ func (o *ItmoCalendarAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/auth/callback"] = auth.NewCompleteLogin(o.context, o.AuthCompleteLoginHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/{isu}/caldav-target"] = settings.NewDeleteCalDavTarget(o.context, o.SettingsDeleteCalDavTargetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/subscription"] = cal_dav.NewDeleteSubscription(o.context, o.CalDavDeleteSubscriptionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/meeting-slots"] = schedule.NewFindMeetingSlots(o.context, o.ScheduleFindMeetingSlotsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/{isu}/caldav-target"] = settings.NewGetCalDavTarget(o.context, o.SettingsGetCalDavTargetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/{isu}/freebusy"] = schedule.NewGetFreeBusy(o.context, o.ScheduleGetFreeBusyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -299,15 +523,75 @@ func (o *ItmoCalendarAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/{isu}/reminders"] = settings.NewGetReminders(o.context, o.SettingsGetRemindersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/{isu}/schedule"] = schedule.NewGetSchedule(o.context, o.ScheduleGetScheduleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/{isu}/schedule/changes"] = schedule.NewGetScheduleChanges(o.context, o.ScheduleGetScheduleChangesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/{isu}/settings"] = settings.NewGetSettings(o.context, o.SettingsGetSettingsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/status"] = cal_dav.NewGetSyncStatus(o.context, o.CalDavGetSyncStatusHandler)
+	if o.handlers["HEAD"] == nil {
+		o.handlers["HEAD"] = make(map[string]http.Handler)
+	}
+	o.handlers["HEAD"]["/{isu}/ical"] = cal_dav.NewHeadICal(o.context, o.CalDavHeadICalHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/health"] = system.NewHealthCheck(o.context, o.SystemHealthCheckHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/auth/logout"] = auth.NewLogout(o.context, o.AuthLogoutHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/refresh"] = auth.NewRefreshSession(o.context, o.AuthRefreshSessionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/{isu}/feed/token"] = settings.NewRevokeFeedToken(o.context, o.SettingsRevokeFeedTokenHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/{isu}/freebusy/token"] = settings.NewRevokeFreeBusyToken(o.context, o.SettingsRevokeFreeBusyTokenHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/{isu}/feed/token"] = settings.NewRotateFeedToken(o.context, o.SettingsRotateFeedTokenHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/{isu}/freebusy/token"] = settings.NewRotateFreeBusyToken(o.context, o.SettingsRotateFreeBusyTokenHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/auth/start"] = auth.NewStartLogin(o.context, o.AuthStartLoginHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/subscribe"] = cal_dav.NewSubscribeSchedule(o.context, o.CalDavSubscribeScheduleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/{isu}/caldav-target"] = settings.NewUpdateCalDavTarget(o.context, o.SettingsUpdateCalDavTargetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/{isu}/reminders"] = settings.NewUpdateReminders(o.context, o.SettingsUpdateRemindersHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/{isu}/settings"] = settings.NewUpdateSettings(o.context, o.SettingsUpdateSettingsHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
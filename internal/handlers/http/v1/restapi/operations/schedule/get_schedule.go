@@ -9,19 +9,21 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
 )
 
 // GetScheduleHandlerFunc turns a function with the right signature into a get schedule handler
-type GetScheduleHandlerFunc func(GetScheduleParams) middleware.Responder
+type GetScheduleHandlerFunc func(GetScheduleParams, *entities.User) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn GetScheduleHandlerFunc) Handle(params GetScheduleParams) middleware.Responder {
-	return fn(params)
+func (fn GetScheduleHandlerFunc) Handle(params GetScheduleParams, principal *entities.User) middleware.Responder {
+	return fn(params, principal)
 }
 
 // GetScheduleHandler interface for that can handle valid get schedule params
 type GetScheduleHandler interface {
-	Handle(GetScheduleParams) middleware.Responder
+	Handle(GetScheduleParams, *entities.User) middleware.Responder
 }
 
 // NewGetSchedule creates a new http.Handler for the get schedule operation
@@ -35,6 +37,7 @@ func NewGetSchedule(ctx *middleware.Context, handler GetScheduleHandler) *GetSch
 Get user's schedule by ISU.
 
 Returns the schedule for the user with the given ISU.
+Only served when legacy ISU paths are enabled, answers carry a Deprecation header.
 */
 type GetSchedule struct {
 	Context *middleware.Context
@@ -47,12 +50,25 @@ func (o *GetSchedule) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewGetScheduleParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *entities.User
+	if uprinc != nil {
+		principal = uprinc.(*entities.User) // this is really a entities.User, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
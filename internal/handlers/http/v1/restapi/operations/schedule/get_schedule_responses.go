@@ -61,6 +61,96 @@ func (o *GetScheduleOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	}
 }
 
+// GetScheduleUnauthorizedCode is the HTTP code returned for type GetScheduleUnauthorized
+const GetScheduleUnauthorizedCode int = 401
+
+/*
+GetScheduleUnauthorized Missing, invalid or revoked access token.
+
+swagger:response getScheduleUnauthorized
+*/
+type GetScheduleUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetScheduleUnauthorized creates GetScheduleUnauthorized with default headers values
+func NewGetScheduleUnauthorized() *GetScheduleUnauthorized {
+
+	return &GetScheduleUnauthorized{}
+}
+
+// WithPayload adds the payload to the get schedule unauthorized response
+func (o *GetScheduleUnauthorized) WithPayload(payload *models.Error) *GetScheduleUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get schedule unauthorized response
+func (o *GetScheduleUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetScheduleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetScheduleForbiddenCode is the HTTP code returned for type GetScheduleForbidden
+const GetScheduleForbiddenCode int = 403
+
+/*
+GetScheduleForbidden The access token belongs to another user.
+
+swagger:response getScheduleForbidden
+*/
+type GetScheduleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetScheduleForbidden creates GetScheduleForbidden with default headers values
+func NewGetScheduleForbidden() *GetScheduleForbidden {
+
+	return &GetScheduleForbidden{}
+}
+
+// WithPayload adds the payload to the get schedule forbidden response
+func (o *GetScheduleForbidden) WithPayload(payload *models.Error) *GetScheduleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get schedule forbidden response
+func (o *GetScheduleForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetScheduleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetScheduleNotFoundCode is the HTTP code returned for type GetScheduleNotFound
 const GetScheduleNotFoundCode int = 404
 
@@ -0,0 +1,27 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func (h *Handler) RevokeFreeBusyTokenHandler(params apiSettings.RevokeFreeBusyTokenParams, principal *entities.User) middleware.Responder {
+	revoked, err := h.usecases.RevokeShareToken.Execute(params.HTTPRequest.Context(), params.Isu, entities.ShareScopeFreeBusy)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "revoke free busy token", err)
+	}
+	if !revoked {
+		return apiSettings.NewRevokeFreeBusyTokenNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrShareTokenNotFound),
+		})
+	}
+
+	return apiSettings.NewRevokeFreeBusyTokenNoContent()
+}
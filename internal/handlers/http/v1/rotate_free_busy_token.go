@@ -0,0 +1,29 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func (h *Handler) RotateFreeBusyTokenHandler(params apiSettings.RotateFreeBusyTokenParams, principal *entities.User) middleware.Responder {
+	token, err := h.usecases.RotateShareToken.Execute(params.HTTPRequest.Context(), params.Isu, entities.ShareScopeFreeBusy)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "rotate free busy token", err)
+	}
+	if token == "" {
+		return apiSettings.NewRotateFreeBusyTokenNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrUserNotFound),
+		})
+	}
+
+	return apiSettings.NewRotateFreeBusyTokenOK().WithPayload(&models.ShareToken{
+		Token: &token,
+	})
+}
@@ -0,0 +1,41 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	apiAuth "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/auth"
+)
+
+// _loginCookie binds a login to the browser that started it, so a callback link can not be
+// passed to someone else to make them subscribe to the sender's account.
+const _loginCookie = "itmo_calendar_login"
+
+func (h *Handler) StartLoginHandler(params apiAuth.StartLoginParams) middleware.Responder {
+	login, err := h.usecases.StartLogin.Execute(params.HTTPRequest.Context())
+	if err != nil {
+		return h.fail(params.HTTPRequest, "start login", err)
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		http.SetCookie(w, loginCookie(params.HTTPRequest, login.State, 0))
+		apiAuth.NewStartLoginFound().WithLocation(login.URL).WriteResponse(w, p)
+	})
+}
+
+// loginCookie returns the login cookie with the state, a negative max age removes it.
+func loginCookie(r *http.Request, state string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     _loginCookie,
+		Value:    state,
+		Path:     "/api/v1/auth",
+		MaxAge:   maxAge,
+		Secure:   r.TLS != nil,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
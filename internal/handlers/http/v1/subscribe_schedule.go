@@ -3,29 +3,81 @@
 package api
 
 import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
 
+	"github.com/hexarchy/itmo-calendar/internal/entities"
 	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
 	apiCalDav "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/cal_dav"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/logins"
 )
 
+// SubscribeScheduleHandler is the deprecated password login, browsers should use /auth/start.
 func (h *Handler) SubscribeScheduleHandler(params apiCalDav.SubscribeScheduleParams) middleware.Responder {
-	if params.Body.Isu == nil || params.Body.Password == nil {
+	return deprecated(h.subscribeSchedule(params), "/api/v1/auth/start")
+}
+
+func (h *Handler) subscribeSchedule(params apiCalDav.SubscribeScheduleParams) middleware.Responder {
+	body := params.Body
+	creds := entities.Credentials{
+		ChallengeID: body.ChallengeID,
+		Answer: entities.ChallengeAnswer{
+			OTP:         body.Otp,
+			NewPassword: body.NewPassword,
+			AcceptTerms: body.AcceptTerms,
+		},
+		ISU:      body.Isu,
+		Password: body.Password,
+	}
+	if !creds.Challenged() && (creds.ISU == 0 || creds.Password == "") {
 		return apiCalDav.NewSubscribeScheduleBadRequest().WithPayload(&models.Error{
 			Error:   "BadRequest",
-			Message: "ISU and password are required",
+			Code:    "credentials_required",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrCredentialsRequired),
 		})
 	}
 
-	err := h.usecases.SubscirbeSchedule.Execute(params.HTTPRequest.Context(), *params.Body.Isu, *params.Body.Password)
-	if err != nil {
-		return apiCalDav.NewSubscribeScheduleInternalServerError().WithPayload(&models.Error{
-			Error:   "InternalServerError",
-			Message: err.Error(),
+	subscription, err := h.usecases.SubscirbeSchedule.Execute(params.HTTPRequest.Context(), creds)
+	var challenge *logins.ChallengeError
+	if errors.As(err, &challenge) {
+		return apiCalDav.NewSubscribeScheduleAccepted().WithPayload(&models.LoginChallenge{
+			ChallengeID: challenge.Challenge.ID,
+			Type:        string(challenge.Challenge.Kind),
+			Message:     challenge.Challenge.Message,
+			ExpiresAt:   strfmt.DateTime(challenge.Challenge.ExpiresAt),
 		})
 	}
+	if err != nil {
+		return h.fail(params.HTTPRequest, "subscribe schedule", err)
+	}
+
+	return apiCalDav.NewSubscribeScheduleOK().WithPayload(subscriptionToDTO(params.HTTPRequest, subscription))
+}
+
+func subscriptionToDTO(r *http.Request, subscription *entities.Subscription) *models.SubscribeResponse {
+	response := &models.SubscribeResponse{
+		Message: i18n.T(locale(r), i18n.MsgSubscribed),
+		Session: sessionToDTO(subscription.Session),
+	}
+	if subscription.FeedToken != "" {
+		response.FeedToken = subscription.FeedToken
+		response.FeedURL = "/feeds/" + subscription.FeedToken + ".ics"
+		response.CaldavURL = "/caldav/" + subscription.FeedToken + "/"
+	}
+
+	return response
+}
 
-	return apiCalDav.NewSubscribeScheduleOK().WithPayload(&models.SubscribeResponse{
-		Message: "Subscription successful. iCal generated.",
+// deprecated marks the response as deprecated in favour of the successor.
+func deprecated(next middleware.Responder, successor string) middleware.Responder {
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", "<"+successor+`>; rel="successor-version"`)
+		next.WriteResponse(w, p)
 	})
 }
@@ -0,0 +1,43 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	"github.com/hexarchy/itmo-calendar/internal/services/caldav"
+)
+
+func (h *Handler) UpdateCalDavTargetHandler(params apiSettings.UpdateCalDavTargetParams, principal *entities.User) middleware.Responder {
+	target := entities.CalDavTarget{
+		ISU:      params.Isu,
+		URL:      *params.Body.URL,
+		Username: params.Body.Username,
+		Password: string(params.Body.Password),
+	}
+
+	found, err := h.usecases.UpdateCalDavTarget.Execute(params.HTTPRequest.Context(), target)
+	if err != nil {
+		if errors.Is(err, caldav.ErrInvalidTarget) {
+			return apiSettings.NewUpdateCalDavTargetBadRequest().WithPayload(&models.Error{
+				Error:   "BadRequest",
+				Message: i18n.T(locale(params.HTTPRequest), i18n.ErrInvalidTarget),
+			})
+		}
+
+		return h.fail(params.HTTPRequest, "update caldav target", err)
+	}
+	if !found {
+		return apiSettings.NewUpdateCalDavTargetNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrUserNotFound),
+		})
+	}
+
+	return apiSettings.NewUpdateCalDavTargetOK().WithPayload(calDavTargetToDTO(target))
+}
@@ -0,0 +1,44 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func (h *Handler) UpdateRemindersHandler(params apiSettings.UpdateRemindersParams, principal *entities.User) middleware.Responder {
+	rules := make([]entities.ReminderRule, 0, len(params.Body.Rules))
+	for _, ruleDTO := range params.Body.Rules {
+		if ruleDTO == nil {
+			continue
+		}
+
+		rule := entities.ReminderRule{
+			LessonType: ruleDTO.LessonType,
+			Format:     ruleDTO.Format,
+		}
+		if ruleDTO.MinutesBefore != nil {
+			minutes := int(*ruleDTO.MinutesBefore)
+			rule.MinutesBefore = &minutes
+		}
+		rules = append(rules, rule)
+	}
+
+	found, err := h.usecases.UpdateReminders.Execute(params.HTTPRequest.Context(), params.Isu, rules)
+	if err != nil {
+		return h.fail(params.HTTPRequest, "update reminders", err)
+	}
+	if !found {
+		return apiSettings.NewUpdateRemindersNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrUserNotFound),
+		})
+	}
+
+	return apiSettings.NewUpdateRemindersOK().WithPayload(remindersToDTO(rules))
+}
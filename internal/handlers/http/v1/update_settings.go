@@ -0,0 +1,43 @@
+// This file is safe to edit. Once it exists it will not be overwritten
+
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/models"
+	apiSettings "github.com/hexarchy/itmo-calendar/internal/handlers/http/v1/restapi/operations/settings"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+	updatesettings "github.com/hexarchy/itmo-calendar/internal/use-cases/update-settings"
+)
+
+func (h *Handler) UpdateSettingsHandler(params apiSettings.UpdateSettingsParams, principal *entities.User) middleware.Responder {
+	settings, err := h.usecases.UpdateSettings.Execute(params.HTTPRequest.Context(), params.Isu, settingsFromDTO(params.Body))
+	if err != nil {
+		var message string
+		switch {
+		case errors.Is(err, updatesettings.ErrInvalidTimeZone):
+			message = i18n.T(locale(params.HTTPRequest), i18n.ErrInvalidTimeZone, params.Body.TimeZone)
+		case errors.Is(err, updatesettings.ErrInvalidLocale):
+			message = i18n.T(locale(params.HTTPRequest), i18n.ErrInvalidLocale, params.Body.Locale)
+		}
+		if message != "" {
+			return apiSettings.NewUpdateSettingsBadRequest().WithPayload(&models.Error{
+				Error:   "BadRequest",
+				Message: message,
+			})
+		}
+
+		return h.fail(params.HTTPRequest, "update settings", err)
+	}
+	if settings == nil {
+		return apiSettings.NewUpdateSettingsNotFound().WithPayload(&models.Error{
+			Error:   "NotFound",
+			Message: i18n.T(locale(params.HTTPRequest), i18n.ErrUserNotFound),
+		})
+	}
+
+	return apiSettings.NewUpdateSettingsOK().WithPayload(settingsToDTO(*settings))
+}
@@ -3,6 +3,7 @@ package sendschedule
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/hexarchy/itmo-calendar/pkg/rabbitmq"
 
@@ -10,7 +11,7 @@ import (
 )
 
 type UseCase interface {
-	Execute(ctx context.Context, isus []int64) error
+	Execute(ctx context.Context, isus []int64, scheduledAt time.Time) error
 }
 
 // Worker handles tasks from the send-schedule queue.
@@ -34,8 +35,10 @@ func New(rabbit *rabbitmq.Client, useCase UseCase, queue string, logger *zap.Log
 // Start launches the worker to consume tasks.
 func (w *Worker) Start(ctx context.Context) error {
 	processFunc := func(ctx context.Context, msg *rabbitmq.Message) error {
+		// Tasks queued before scheduled_at was sent have a zero time and skip every user deleted once.
 		var payload struct {
-			ISUs []int64 `json:"isus"`
+			ISUs        []int64   `json:"isus"`
+			ScheduledAt time.Time `json:"scheduled_at"`
 		}
 
 		err := json.Unmarshal(msg.Body, &payload)
@@ -46,7 +49,7 @@ func (w *Worker) Start(ctx context.Context) error {
 
 		w.logger.Debug("processing send-schedule task", zap.String("queue", w.queue), zap.Any("payload", payload))
 
-		err = w.useCase.Execute(ctx, payload.ISUs)
+		err = w.useCase.Execute(ctx, payload.ISUs, payload.ScheduledAt)
 		if err != nil {
 			w.logger.Error("failed to execute send-schedule use case", zap.Error(err))
 			return err
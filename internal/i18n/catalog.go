@@ -0,0 +1,173 @@
+package i18n
+
+import "github.com/hexarchy/itmo-calendar/internal/entities"
+
+const (
+	CalendarName        Key = "calendar.name"
+	CalendarDescription Key = "calendar.description"
+	CancelledPrefix     Key = "calendar.cancelled_prefix"
+	LabelFormat         Key = "calendar.label.format"
+	LabelGroup          Key = "calendar.label.group"
+	LabelNote           Key = "calendar.label.note"
+	LabelRoom           Key = "calendar.label.room"
+
+	WeekOdd     Key = "week.odd"
+	WeekEven    Key = "week.even"
+	WeekSession Key = "week.session"
+	WeekHoliday Key = "week.holiday"
+
+	ReauthSummary     Key = "reauth.summary"
+	ReauthDescription Key = "reauth.description"
+
+	TypeLecture  Key = "lesson_type.lecture"
+	TypePractice Key = "lesson_type.practice"
+	TypeLab      Key = "lesson_type.lab"
+
+	ErrUserNotFound        Key = "error.user_not_found"
+	ErrICalNotFound        Key = "error.ical_not_found"
+	ErrScheduleNotFound    Key = "error.schedule_not_found"
+	ErrCredentialsRequired Key = "error.credentials_required"
+	ErrInvalidTimeZone     Key = "error.invalid_time_zone"
+	ErrInvalidLocale       Key = "error.invalid_locale"
+	ErrInvalidTemplate     Key = "error.invalid_template"
+	ErrInvalidReminders    Key = "error.invalid_reminders"
+	ErrSyncTokenExpired    Key = "error.sync_token_expired"
+	ErrTargetNotFound      Key = "error.target_not_found"
+	ErrInvalidTarget       Key = "error.invalid_target"
+	ErrInvalidRange        Key = "error.invalid_range"
+	ErrInvalidShareToken   Key = "error.invalid_share_token"
+	ErrShareTokenNotFound  Key = "error.share_token_not_found"
+	ErrInvalidMeetingQuery Key = "error.invalid_meeting_query"
+	ErrLegacyPathDisabled  Key = "error.legacy_path_disabled"
+	ErrUnauthorized        Key = "error.unauthorized"
+	ErrForbidden           Key = "error.forbidden"
+	ErrRefreshTokenMissing Key = "error.refresh_token_missing"
+	ErrInvalidSession      Key = "error.invalid_session"
+	ErrLoginFailed         Key = "error.login_failed"
+	ErrLoginStateMismatch  Key = "error.login_state_mismatch"
+	ErrLoginExpired        Key = "error.login_expired"
+	ErrAnswerMissing       Key = "error.challenge_answer_missing"
+	ErrInvalidCredentials  Key = "error.invalid_credentials"
+	ErrTokenExpired        Key = "error.token_expired"
+	ErrRateLimited         Key = "error.rate_limited"
+	ErrUpstreamUnavailable Key = "error.upstream_unavailable"
+	ErrInternal            Key = "error.internal"
+
+	MsgSubscribed Key = "message.subscribed"
+)
+
+// _lessonTypes maps lesson kinds to their translations.
+var _lessonTypes = map[entities.LessonKind]Key{
+	entities.LessonKindLecture:  TypeLecture,
+	entities.LessonKindPractice: TypePractice,
+	entities.LessonKindLab:      TypeLab,
+}
+
+var _catalog = map[Locale]map[Key]string{
+	RU: {
+		CalendarName:        "Расписание ИТМО",
+		CalendarDescription: "Расписание занятий Университета ИТМО",
+		CancelledPrefix:     "Отменено: ",
+		LabelFormat:         "Формат",
+		LabelGroup:          "Группа",
+		LabelNote:           "Заметки",
+		LabelRoom:           "Аудитория",
+
+		WeekOdd:     "Неделя %d (нечётная)",
+		WeekEven:    "Неделя %d (чётная)",
+		WeekSession: "Сессия",
+		WeekHoliday: "Каникулы",
+
+		ReauthSummary:     "Требуется действие: оформите подписку заново",
+		ReauthDescription: "Доступ к ITMO ID истёк или был отозван, расписание больше не обновляется. Войдите заново, чтобы возобновить синхронизацию, ссылка на календарь останется прежней.",
+
+		TypeLecture:  "Лекция",
+		TypePractice: "Практика",
+		TypeLab:      "Лабораторная",
+
+		ErrUserNotFound:        "Пользователь не найден",
+		ErrICalNotFound:        "Календарь не найден",
+		ErrScheduleNotFound:    "Расписание не найдено",
+		ErrCredentialsRequired: "Необходимо указать ИСУ и пароль",
+		ErrInvalidTimeZone:     "Неизвестный часовой пояс: %s",
+		ErrInvalidLocale:       "Неподдерживаемый язык: %s",
+		ErrInvalidTemplate:     "Некорректный шаблон события",
+		ErrInvalidReminders:    "Некорректные правила напоминаний",
+		ErrSyncTokenExpired:    "Токен синхронизации устарел, загрузите расписание целиком",
+		ErrTargetNotFound:      "CalDAV-календарь не настроен",
+		ErrInvalidTarget:       "Адрес CalDAV-календаря должен быть абсолютным http(s) URL публичного сервера",
+		ErrInvalidRange:        "Некорректный период: конец должен быть позже начала, не более 92 дней",
+		ErrInvalidShareToken:   "Ссылка недействительна или отозвана",
+		ErrShareTokenNotFound:  "Ссылка не выпускалась",
+		ErrInvalidMeetingQuery: "Некорректный запрос поиска встречи",
+		ErrLegacyPathDisabled:  "Доступ к календарю по ИСУ отключён, используйте секретную ссылку на фид",
+		ErrUnauthorized:        "Нужен действующий токен доступа",
+		ErrForbidden:           "Токен доступа выдан другому пользователю",
+		ErrRefreshTokenMissing: "Укажите токен обновления",
+		ErrInvalidSession:      "Сессия недействительна, оформите подписку заново",
+		ErrLoginFailed:         "Не удалось войти через ITMO ID",
+		ErrLoginStateMismatch:  "Вход начат в другом браузере, начните его заново",
+		ErrLoginExpired:        "Вход устарел или уже завершён, начните его заново",
+		ErrAnswerMissing:       "Ответ не подходит к запросу ITMO ID: нужен код, новый пароль или согласие с условиями",
+		ErrInvalidCredentials:  "Неверный ИСУ или пароль",
+		ErrTokenExpired:        "Доступ к ITMO ID истёк, оформите подписку заново",
+		ErrRateLimited:         "ИТМО ограничивает число запросов, попробуйте позже",
+		ErrUpstreamUnavailable: "Сервисы ИТМО недоступны, попробуйте позже",
+		ErrInternal:            "Внутренняя ошибка сервера",
+
+		MsgSubscribed: "Подписка оформлена. Календарь сформирован.",
+	},
+	EN: {
+		CalendarName:        "ITMO Calendar",
+		CalendarDescription: "ITMO University class schedule",
+		CancelledPrefix:     "Cancelled: ",
+		LabelFormat:         "Format",
+		LabelGroup:          "Group",
+		LabelNote:           "Notes",
+		LabelRoom:           "Room",
+
+		WeekOdd:     "Week %d (odd)",
+		WeekEven:    "Week %d (even)",
+		WeekSession: "Exam session",
+		WeekHoliday: "Holidays",
+
+		ReauthSummary:     "Action required: re-subscribe",
+		ReauthDescription: "Access to ITMO ID expired or was revoked, the schedule is no longer updated. Log in again to resume syncing, the calendar link stays the same.",
+
+		TypeLecture:  "Lecture",
+		TypePractice: "Practice",
+		TypeLab:      "Lab",
+
+		ErrUserNotFound:        "user not found",
+		ErrICalNotFound:        "iCal not found",
+		ErrScheduleNotFound:    "schedule not found",
+		ErrCredentialsRequired: "ISU and password are required",
+		ErrInvalidTimeZone:     "unknown time zone: %s",
+		ErrInvalidLocale:       "unsupported locale: %s",
+		ErrInvalidTemplate:     "invalid event template",
+		ErrInvalidReminders:    "invalid reminder rules",
+		ErrSyncTokenExpired:    "sync token expired, fetch the full schedule",
+		ErrTargetNotFound:      "CalDAV target is not configured",
+		ErrInvalidTarget:       "CalDAV target URL must be an absolute http(s) URL of a public server",
+		ErrInvalidRange:        "invalid range: the end must be after the start and at most 92 days later",
+		ErrInvalidShareToken:   "share token is invalid or revoked",
+		ErrShareTokenNotFound:  "no share token issued",
+		ErrInvalidMeetingQuery: "invalid meeting query",
+		ErrLegacyPathDisabled:  "calendars by ISU are disabled, use the secret feed URL",
+		ErrUnauthorized:        "a valid access token is required",
+		ErrForbidden:           "the access token was issued to another user",
+		ErrRefreshTokenMissing: "refresh token is required",
+		ErrInvalidSession:      "the session is invalid, subscribe again",
+		ErrLoginFailed:         "ITMO ID login failed",
+		ErrLoginStateMismatch:  "the login was started in another browser, start it again",
+		ErrLoginExpired:        "the login expired or is already completed, start it again",
+		ErrAnswerMissing:       "the answer does not fit the ITMO ID challenge: a code, a new password or accepting the terms is required",
+		ErrInvalidCredentials:  "invalid ISU or password",
+		ErrTokenExpired:        "ITMO ID access expired, subscribe again",
+		ErrRateLimited:         "ITMO throttles the requests, try again later",
+		ErrUpstreamUnavailable: "ITMO services are unavailable, try again later",
+		ErrInternal:            "internal server error",
+
+		MsgSubscribed: "Subscription successful. iCal generated.",
+	},
+}
@@ -0,0 +1,116 @@
+// Package i18n holds translations of user-facing strings.
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// Locale is a supported language.
+type Locale string
+
+const (
+	RU Locale = "ru"
+	EN Locale = "en"
+)
+
+// Default is the locale of calendars of users who did not choose one.
+const Default = RU
+
+// Key identifies a translatable message.
+type Key string
+
+// Supported reports whether the locale has a catalog.
+func Supported(locale string) bool {
+	_, ok := _catalog[Locale(locale)]
+	return ok
+}
+
+// Parse returns the locale for a language tag such as "en" or "en-US", or Default if it is not supported.
+func Parse(tag string) Locale {
+	if locale, ok := match(tag); ok {
+		return locale
+	}
+
+	return Default
+}
+
+// FromAcceptLanguage picks the preferred supported locale of an Accept-Language header,
+// returning fallback when none of the languages is supported.
+func FromAcceptLanguage(header string, fallback Locale) Locale {
+	type language struct {
+		tag     string
+		quality float64
+	}
+
+	var languages []language
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = v
+			}
+		}
+		if quality > 0 {
+			languages = append(languages, language{tag: tag, quality: quality})
+		}
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	for _, l := range languages {
+		if locale, ok := match(l.tag); ok {
+			return locale
+		}
+	}
+
+	return fallback
+}
+
+// T returns the message for the key in the given locale formatted with args.
+// Messages missing from the locale fall back to Default, unknown keys are returned as is.
+func T(locale Locale, key Key, args ...any) string {
+	message, ok := _catalog[locale][key]
+	if !ok {
+		message, ok = _catalog[Default][key]
+	}
+	if !ok {
+		message = string(key)
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+
+	return message
+}
+
+// LessonType translates a lesson type as named by the ITMO schedule.
+// Types without a translation are returned as is.
+func LessonType(locale Locale, lessonType string) string {
+	if key, ok := _lessonTypes[entities.KindOf(lessonType)]; ok {
+		return T(locale, key)
+	}
+
+	return lessonType
+}
+
+func match(tag string) (Locale, bool) {
+	base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	if Supported(base) {
+		return Locale(base), true
+	}
+
+	return "", false
+}
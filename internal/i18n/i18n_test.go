@@ -0,0 +1,34 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromAcceptLanguage(t *testing.T) {
+	for header, want := range map[string]Locale{
+		"":                             EN,
+		"ru-RU,ru;q=0.9,en;q=0.8":      RU,
+		"de-DE, en-GB;q=0.7, ru;q=0.5": EN,
+		"en;q=0.2, ru;q=0.9":           RU,
+		"fr, *;q=0.1":                  EN,
+		"ru;q=0, en":                   EN,
+	} {
+		assert.Equal(t, want, FromAcceptLanguage(header, EN), header)
+	}
+}
+
+func TestT(t *testing.T) {
+	assert.Equal(t, "Room", T(EN, LabelRoom))
+	assert.Equal(t, "unknown time zone: Mars/Olympus", T(EN, ErrInvalidTimeZone, "Mars/Olympus"))
+	assert.Equal(t, "Аудитория", T(Parse("xx"), LabelRoom))
+	assert.Equal(t, "missing.key", T(EN, "missing.key"))
+}
+
+func TestLessonType(t *testing.T) {
+	assert.Equal(t, "Lecture", LessonType(EN, "Лекции"))
+	assert.Equal(t, "Practice", LessonType(EN, "практические занятия"))
+	assert.Equal(t, "Lab", LessonType(EN, "Лабораторные работы"))
+	assert.Equal(t, "Зачёт", LessonType(EN, "Зачёт"))
+}
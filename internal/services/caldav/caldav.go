@@ -2,6 +2,8 @@ package caldav
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
 	ics "github.com/arran4/golang-ical"
 	"github.com/pkg/errors"
@@ -9,13 +11,20 @@ import (
 	"github.com/hexarchy/itmo-calendar/internal/entities"
 )
 
+// ErrInvalidTarget is returned when a CalDAV target URL is not an absolute http(s) URL of a public host.
+var ErrInvalidTarget = errors.New("invalid caldav target")
+
 type Service struct {
-	repo Repo
+	repo    Repo
+	targets TargetsRepo
+	client  Client
 }
 
-func New(repo Repo) *Service {
+func New(repo Repo, targets TargetsRepo, client Client) *Service {
 	return &Service{
-		repo: repo,
+		repo:    repo,
+		targets: targets,
+		client:  client,
 	}
 }
 
@@ -39,3 +48,147 @@ func (s *Service) Get(ctx context.Context, isu int64) (entities.CalDav, error) {
 
 	return calDav, nil
 }
+
+// GetTarget returns the user's CalDAV target, nil if none is configured.
+func (s *Service) GetTarget(ctx context.Context, isu int64) (*entities.CalDavTarget, error) {
+	target, err := s.targets.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get caldav target")
+	}
+
+	return target, nil
+}
+
+// SetTarget stores the collection the user's lessons are pushed to.
+// Hosts that do not resolve or resolve to non-public addresses are refused.
+func (s *Service) SetTarget(ctx context.Context, target entities.CalDavTarget) error {
+	u, err := url.Parse(target.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidTarget
+	}
+
+	err = s.client.CheckHost(ctx, u.Hostname())
+	if err != nil {
+		return errors.Wrapf(ErrInvalidTarget, "%v", err)
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	target.URL = u.String()
+
+	err = s.targets.Upsert(ctx, target)
+	if err != nil {
+		return errors.Wrap(err, "upsert caldav target")
+	}
+
+	return nil
+}
+
+// DeleteTarget stops pushing to the user's CalDAV target. Returns false if there was none.
+func (s *Service) DeleteTarget(ctx context.Context, isu int64) (bool, error) {
+	deleted, err := s.targets.Delete(ctx, isu)
+	if err != nil {
+		return false, errors.Wrap(err, "delete caldav target")
+	}
+
+	return deleted, nil
+}
+
+// Push writes the calendar objects into the user's CalDAV target, if one is configured.
+// Objects are created only if missing, replaced and deleted only at the ETag of the last write,
+// so resources the user edited on the server, or whose ETag the server did not tell, are counted
+// as conflicts and left alone. Resources the user deleted on the server are not written again.
+func (s *Service) Push(ctx context.Context, isu int64, objects []entities.CalendarObject) (entities.PushResult, error) {
+	var result entities.PushResult
+
+	target, err := s.targets.Get(ctx, isu)
+	if err != nil {
+		return result, errors.Wrap(err, "get caldav target")
+	}
+	if target == nil {
+		return result, nil
+	}
+
+	remotes, err := s.targets.Objects(ctx, isu)
+	if err != nil {
+		return result, errors.Wrap(err, "get caldav target objects")
+	}
+	pushed := make(map[string]entities.RemoteObject, len(remotes))
+	for _, remote := range remotes {
+		pushed[remote.UID] = remote
+	}
+
+	for _, object := range objects {
+		remote, ok := pushed[object.UID]
+		delete(pushed, object.UID)
+
+		if ok && (remote.Deleted || remote.Hash == object.ETag) {
+			result.Unchanged++
+			continue
+		}
+		if !ok {
+			remote = entities.RemoteObject{
+				ISU:  isu,
+				UID:  object.UID,
+				Href: objectHref(target.URL, object.UID),
+			}
+		}
+
+		etag, err := s.client.Put(ctx, *target, remote.Href, object.Data, remote.ETag, !ok)
+		switch {
+		case errors.Is(err, entities.ErrRemoteConflict):
+			result.Conflicts++
+			continue
+		case errors.Is(err, entities.ErrRemoteNotFound):
+			// Deleted on the server, the user does not want it back.
+			remote.Deleted = true
+			err = s.targets.SaveObject(ctx, remote)
+			if err != nil {
+				return result, errors.Wrap(err, "save caldav target object")
+			}
+			result.Conflicts++
+			continue
+		case err != nil:
+			return result, errors.Wrapf(err, "put %s", object.UID)
+		}
+
+		remote.ETag = etag
+		remote.Hash = object.ETag
+		err = s.targets.SaveObject(ctx, remote)
+		if err != nil {
+			return result, errors.Wrap(err, "save caldav target object")
+		}
+		if ok {
+			result.Updated++
+		} else {
+			result.Created++
+		}
+	}
+
+	for uid, remote := range pushed {
+		// Either way the resource is no longer ours to track.
+		if !remote.Deleted {
+			err = s.client.Delete(ctx, *target, remote.Href, remote.ETag)
+			switch {
+			case errors.Is(err, entities.ErrRemoteConflict):
+				result.Conflicts++
+			case errors.Is(err, entities.ErrRemoteNotFound):
+			case err != nil:
+				return result, errors.Wrapf(err, "delete %s", uid)
+			default:
+				result.Deleted++
+			}
+		}
+
+		err = s.targets.DeleteObject(ctx, isu, uid)
+		if err != nil {
+			return result, errors.Wrap(err, "forget caldav target object")
+		}
+	}
+
+	return result, nil
+}
+
+func objectHref(collection, uid string) string {
+	return strings.TrimSuffix(collection, "/") + "/" + url.PathEscape(uid) + ".ics"
+}
@@ -0,0 +1,304 @@
+package caldav
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	caldavclient "github.com/hexarchy/itmo-calendar/internal/adapters/caldav-client"
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type memoryTargets struct {
+	target  *entities.CalDavTarget
+	objects map[string]entities.RemoteObject
+}
+
+func (r *memoryTargets) Get(_ context.Context, _ int64) (*entities.CalDavTarget, error) {
+	return r.target, nil
+}
+
+func (r *memoryTargets) Upsert(_ context.Context, target entities.CalDavTarget) error {
+	r.target = &target
+	r.objects = map[string]entities.RemoteObject{}
+	return nil
+}
+
+func (r *memoryTargets) Delete(_ context.Context, _ int64) (bool, error) {
+	deleted := r.target != nil
+	r.target = nil
+	r.objects = nil
+	return deleted, nil
+}
+
+func (r *memoryTargets) Objects(_ context.Context, _ int64) ([]entities.RemoteObject, error) {
+	var objects []entities.RemoteObject
+	for _, object := range r.objects {
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+func (r *memoryTargets) SaveObject(_ context.Context, object entities.RemoteObject) error {
+	r.objects[object.UID] = object
+	return nil
+}
+
+func (r *memoryTargets) DeleteObject(_ context.Context, _ int64, uid string) error {
+	delete(r.objects, uid)
+	return nil
+}
+
+// davServer is a minimal WebDAV collection honouring If-Match and If-None-Match.
+type davServer struct {
+	mu        sync.Mutex
+	resources map[string]string
+	etags     map[string]string
+	version   int
+	// noETags makes the server answer without ETags, as servers that alter the data do.
+	noETags bool
+}
+
+func newDAVServer() *davServer {
+	return &davServer{
+		resources: map[string]string{},
+		etags:     map[string]string{},
+	}
+}
+
+// edit changes a resource the way another client would.
+func (d *davServer) edit(path, data string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.version++
+	d.resources[path] = data
+	d.etags[path] = fmt.Sprintf(`"v%d"`, d.version)
+}
+
+// remove deletes a resource the way another client would.
+func (d *davServer) remove(path string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.resources, path)
+	delete(d.etags, path)
+}
+
+func (d *davServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if user, password, ok := r.BasicAuth(); !ok || user != "student" || password != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	etag, exists := d.etags[r.URL.Path]
+	match := r.Header.Get("If-Match")
+	if match != "" && !exists {
+		// Like servers that look the resource up before checking preconditions.
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if match != "" && match != etag {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return
+	}
+	if r.Header.Get("If-None-Match") == "*" && exists {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		d.version++
+		d.resources[r.URL.Path] = string(body)
+		d.etags[r.URL.Path] = fmt.Sprintf(`"v%d"`, d.version)
+		if !d.noETags {
+			w.Header().Set("ETag", d.etags[r.URL.Path])
+		}
+		if exists {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			w.WriteHeader(http.StatusCreated)
+		}
+	case http.MethodDelete:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(d.resources, r.URL.Path)
+		delete(d.etags, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodHead:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !d.noETags {
+			w.Header().Set("ETag", etag)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func object(uid, data string) entities.CalendarObject {
+	return entities.CalendarObject{UID: uid, Data: data, ETag: `"` + data + `"`}
+}
+
+func TestPush(t *testing.T) {
+	ctx := context.Background()
+	dav := newDAVServer()
+	server := httptest.NewServer(dav)
+	defer server.Close()
+
+	targets := &memoryTargets{}
+	s := New(nil, targets, caldavclient.New(time.Second, true))
+
+	result, err := s.Push(ctx, 1, []entities.CalendarObject{object("a", "A1")})
+	require.NoError(t, err)
+	assert.Equal(t, entities.PushResult{}, result, "no target configured")
+
+	require.ErrorIs(t, s.SetTarget(ctx, entities.CalDavTarget{ISU: 1, URL: "ftp://example.com"}), ErrInvalidTarget)
+	require.NoError(t, s.SetTarget(ctx, entities.CalDavTarget{
+		ISU:      1,
+		URL:      server.URL + "/calendars/student",
+		Username: "student",
+		Password: "secret",
+	}))
+
+	t.Run("create", func(t *testing.T) {
+		result, err := s.Push(ctx, 1, []entities.CalendarObject{object("a", "A1"), object("b@itmo", "B1")})
+		require.NoError(t, err)
+		assert.Equal(t, entities.PushResult{Created: 2}, result)
+		assert.Equal(t, "A1", dav.resources["/calendars/student/a.ics"])
+		assert.Equal(t, "B1", dav.resources["/calendars/student/b@itmo.ics"])
+	})
+
+	t.Run("update and delete", func(t *testing.T) {
+		result, err := s.Push(ctx, 1, []entities.CalendarObject{object("a", "A2")})
+		require.NoError(t, err)
+		assert.Equal(t, entities.PushResult{Updated: 1, Deleted: 1}, result)
+		assert.Equal(t, "A2", dav.resources["/calendars/student/a.ics"])
+		assert.NotContains(t, dav.resources, "/calendars/student/b@itmo.ics")
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		result, err := s.Push(ctx, 1, []entities.CalendarObject{object("a", "A2")})
+		require.NoError(t, err)
+		assert.Equal(t, entities.PushResult{Unchanged: 1}, result)
+	})
+
+	t.Run("edited elsewhere", func(t *testing.T) {
+		dav.edit("/calendars/student/a.ics", "A2 with notes")
+
+		result, err := s.Push(ctx, 1, []entities.CalendarObject{object("a", "A3")})
+		require.NoError(t, err)
+		assert.Equal(t, entities.PushResult{Conflicts: 1}, result)
+		assert.Equal(t, "A2 with notes", dav.resources["/calendars/student/a.ics"])
+
+		result, err = s.Push(ctx, 1, nil)
+		require.NoError(t, err)
+		assert.Equal(t, entities.PushResult{Conflicts: 1}, result)
+		assert.Equal(t, "A2 with notes", dav.resources["/calendars/student/a.ics"])
+		assert.Empty(t, targets.objects)
+	})
+}
+
+func TestPushWithoutETags(t *testing.T) {
+	ctx := context.Background()
+	dav := newDAVServer()
+	dav.noETags = true
+	server := httptest.NewServer(dav)
+	defer server.Close()
+
+	targets := &memoryTargets{}
+	s := New(nil, targets, caldavclient.New(time.Second, true))
+	require.NoError(t, s.SetTarget(ctx, entities.CalDavTarget{
+		ISU:      1,
+		URL:      server.URL + "/calendars/student",
+		Username: "student",
+		Password: "secret",
+	}))
+
+	result, err := s.Push(ctx, 1, []entities.CalendarObject{object("a", "A1")})
+	require.NoError(t, err)
+	assert.Equal(t, entities.PushResult{Created: 1}, result)
+	assert.Empty(t, targets.objects["a"].ETag)
+
+	// Without an ETag an edit made elsewhere can not be told apart, the resource is left alone.
+	result, err = s.Push(ctx, 1, []entities.CalendarObject{object("a", "A2")})
+	require.NoError(t, err)
+	assert.Equal(t, entities.PushResult{Conflicts: 1}, result)
+	assert.Equal(t, "A1", dav.resources["/calendars/student/a.ics"])
+
+	result, err = s.Push(ctx, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, entities.PushResult{Conflicts: 1}, result)
+	assert.Equal(t, "A1", dav.resources["/calendars/student/a.ics"])
+}
+
+func TestPushDeletedRemotely(t *testing.T) {
+	ctx := context.Background()
+	dav := newDAVServer()
+	server := httptest.NewServer(dav)
+	defer server.Close()
+
+	targets := &memoryTargets{}
+	s := New(nil, targets, caldavclient.New(time.Second, true))
+	require.NoError(t, s.SetTarget(ctx, entities.CalDavTarget{
+		ISU:      1,
+		URL:      server.URL + "/calendars/student",
+		Username: "student",
+		Password: "secret",
+	}))
+
+	result, err := s.Push(ctx, 1, []entities.CalendarObject{object("a", "A1")})
+	require.NoError(t, err)
+	assert.Equal(t, entities.PushResult{Created: 1}, result)
+
+	dav.remove("/calendars/student/a.ics")
+
+	result, err = s.Push(ctx, 1, []entities.CalendarObject{object("a", "A2")})
+	require.NoError(t, err)
+	assert.Equal(t, entities.PushResult{Conflicts: 1}, result)
+	assert.True(t, targets.objects["a"].Deleted)
+
+	result, err = s.Push(ctx, 1, []entities.CalendarObject{object("a", "A3")})
+	require.NoError(t, err)
+	assert.Equal(t, entities.PushResult{Unchanged: 1}, result)
+	assert.NotContains(t, dav.resources, "/calendars/student/a.ics")
+
+	// Once the lesson is gone too there is nothing left to track.
+	result, err = s.Push(ctx, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, entities.PushResult{}, result)
+	assert.Empty(t, targets.objects)
+}
+
+func TestSetTargetPublicHosts(t *testing.T) {
+	ctx := context.Background()
+	s := New(nil, &memoryTargets{}, caldavclient.New(time.Second, false))
+
+	for _, url := range []string{
+		"http://127.0.0.1/calendars/student",
+		"http://localhost:8080/calendars/student",
+		"https://10.1.2.3/calendars/student",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[::1]/calendars/student",
+		"http://[fd00:ec2::254]/",
+	} {
+		assert.ErrorIs(t, s.SetTarget(ctx, entities.CalDavTarget{ISU: 1, URL: url}), ErrInvalidTarget, url)
+	}
+
+	assert.NoError(t, s.SetTarget(ctx, entities.CalDavTarget{ISU: 1, URL: "https://203.0.113.7/calendars/student"}))
+}
@@ -10,3 +10,18 @@ type Repo interface {
 	Create(ctx context.Context, caldav entities.CalDav) error
 	Get(ctx context.Context, isu int64) (entities.CalDav, error)
 }
+
+type TargetsRepo interface {
+	Get(ctx context.Context, isu int64) (*entities.CalDavTarget, error)
+	Upsert(ctx context.Context, target entities.CalDavTarget) error
+	Delete(ctx context.Context, isu int64) (bool, error)
+	Objects(ctx context.Context, isu int64) ([]entities.RemoteObject, error)
+	SaveObject(ctx context.Context, object entities.RemoteObject) error
+	DeleteObject(ctx context.Context, isu int64, uid string) error
+}
+
+type Client interface {
+	CheckHost(ctx context.Context, host string) error
+	Put(ctx context.Context, target entities.CalDavTarget, href, data, ifMatch string, create bool) (string, error)
+	Delete(ctx context.Context, target entities.CalDavTarget, href, ifMatch string) error
+}
@@ -0,0 +1,202 @@
+package changes
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// ErrSyncTokenExpired is returned for a sync token the change log can no longer answer,
+// the client has to fetch the full schedule and start over with a fresh token.
+var ErrSyncTokenExpired = errors.New("sync token expired")
+
+// Service keeps a per-user log of lesson changes and answers sync token queries on it.
+type Service struct {
+	repo      Repo
+	retention time.Duration
+}
+
+func New(repo Repo, retention time.Duration) *Service {
+	return &Service{
+		repo:      repo,
+		retention: retention,
+	}
+}
+
+// Record logs the difference between the previous and the current schedule and prunes
+// changes older than the retention. Previous lessons starting before since left the
+// generated period and are not reported as removed.
+func (s *Service) Record(ctx context.Context, isu int64, previous, current []entities.DaySchedule, since time.Time) error {
+	now := time.Now().UTC()
+
+	err := s.repo.Add(ctx, Diff(isu, previous, current, since, now))
+	if err != nil {
+		return errors.Wrap(err, "add schedule changes")
+	}
+
+	err = s.repo.Prune(ctx, isu, now.Add(-s.retention))
+	if err != nil {
+		return errors.Wrap(err, "prune schedule changes")
+	}
+
+	return nil
+}
+
+// Token returns a sync token pointing at the user's latest change.
+func (s *Service) Token(ctx context.Context, isu int64) (string, error) {
+	latest, prunedThrough, err := s.repo.Bounds(ctx, isu)
+	if err != nil {
+		return "", errors.Wrap(err, "get change log bounds")
+	}
+
+	return formatToken(max(latest, prunedThrough)), nil
+}
+
+// Since returns the changes made after the sync token, coalesced to one per lesson,
+// together with the token to continue from.
+func (s *Service) Since(ctx context.Context, isu int64, token string) (entities.ScheduleChanges, error) {
+	after, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || after < 0 {
+		return entities.ScheduleChanges{}, errors.Wrapf(ErrSyncTokenExpired, "malformed token %q", token)
+	}
+
+	latest, prunedThrough, err := s.repo.Bounds(ctx, isu)
+	if err != nil {
+		return entities.ScheduleChanges{}, errors.Wrap(err, "get change log bounds")
+	}
+	if after < prunedThrough || after > max(latest, prunedThrough) {
+		return entities.ScheduleChanges{}, errors.Wrapf(ErrSyncTokenExpired, "token %q", token)
+	}
+
+	changes, err := s.repo.Since(ctx, isu, after)
+	if err != nil {
+		return entities.ScheduleChanges{}, errors.Wrap(err, "get schedule changes")
+	}
+
+	next := after
+	if len(changes) > 0 {
+		next = changes[len(changes)-1].ID
+	}
+
+	return entities.ScheduleChanges{
+		Changes:   Coalesce(changes),
+		SyncToken: formatToken(next),
+	}, nil
+}
+
+// Snapshot reports every lesson of the schedule as added, it answers a sync without a token.
+func Snapshot(isu int64, schedule []entities.DaySchedule, token string) entities.ScheduleChanges {
+	return entities.ScheduleChanges{
+		Changes:   Diff(isu, nil, schedule, time.Time{}, time.Now().UTC()),
+		SyncToken: token,
+	}
+}
+
+// Diff compares two schedules slot by slot and returns the lessons added, changed and removed.
+func Diff(isu int64, previous, current []entities.DaySchedule, since, now time.Time) []entities.ScheduleChange {
+	type entry struct {
+		date   time.Time
+		lesson entities.Lesson
+	}
+
+	index := func(schedule []entities.DaySchedule) map[string]entry {
+		entries := make(map[string]entry)
+		for _, day := range schedule {
+			for i, slot := range day.Slots() {
+				entries[slot] = entry{date: day.Date, lesson: day.Lessons[i]}
+			}
+		}
+		return entries
+	}
+
+	before := index(previous)
+	changes := make([]entities.ScheduleChange, 0)
+	newChange := func(slot string, changeType entities.ChangeType, e entry) entities.ScheduleChange {
+		change := entities.ScheduleChange{
+			ISU:       isu,
+			UID:       entities.LessonUID(isu, slot),
+			Type:      changeType,
+			Date:      e.date,
+			ChangedAt: now,
+		}
+		if changeType != entities.ChangeTypeRemoved {
+			lesson := e.lesson
+			change.Lesson = &lesson
+		}
+		return change
+	}
+
+	// Changes follow the order of the current schedule, removals come last in the previous one's.
+	for _, day := range current {
+		for i, slot := range day.Slots() {
+			e := entry{date: day.Date, lesson: day.Lessons[i]}
+
+			old, ok := before[slot]
+			switch {
+			case !ok:
+				changes = append(changes, newChange(slot, entities.ChangeTypeAdded, e))
+			case old.lesson.Fingerprint() != e.lesson.Fingerprint():
+				changes = append(changes, newChange(slot, entities.ChangeTypeChanged, e))
+			}
+		}
+	}
+
+	after := index(current)
+	for _, day := range previous {
+		for i, slot := range day.Slots() {
+			if _, ok := after[slot]; ok || day.Lessons[i].Start.Before(since) {
+				continue
+			}
+			changes = append(changes, newChange(slot, entities.ChangeTypeRemoved, entry{date: day.Date}))
+		}
+	}
+
+	return changes
+}
+
+// Coalesce folds the changes of each lesson into one from its state before the first change
+// to its state after the last: a lesson added and then removed disappears, one removed and
+// added again is changed.
+func Coalesce(changes []entities.ScheduleChange) []entities.ScheduleChange {
+	positions := make(map[string]int)
+	existed := make([]bool, 0, len(changes))
+	result := make([]entities.ScheduleChange, 0, len(changes))
+
+	for _, change := range changes {
+		i, ok := positions[change.UID]
+		if !ok {
+			positions[change.UID] = len(result)
+			existed = append(existed, change.Type != entities.ChangeTypeAdded)
+			result = append(result, change)
+			continue
+		}
+
+		result[i] = change
+		exists := change.Type != entities.ChangeTypeRemoved
+		switch {
+		case !existed[i] && exists:
+			result[i].Type = entities.ChangeTypeAdded
+		case existed[i] && exists:
+			result[i].Type = entities.ChangeTypeChanged
+		}
+	}
+
+	coalesced := result[:0]
+	for i, change := range result {
+		// Lessons that appeared and vanished in between are of no interest to the client.
+		if !existed[i] && change.Type == entities.ChangeTypeRemoved {
+			continue
+		}
+		coalesced = append(coalesced, change)
+	}
+
+	return coalesced
+}
+
+func formatToken(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
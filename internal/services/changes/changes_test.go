@@ -0,0 +1,132 @@
+package changes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type memoryRepo struct {
+	changes       []entities.ScheduleChange
+	nextID        int64
+	prunedThrough int64
+}
+
+func (r *memoryRepo) Add(_ context.Context, changes []entities.ScheduleChange) error {
+	for _, change := range changes {
+		r.nextID++
+		change.ID = r.nextID
+		r.changes = append(r.changes, change)
+	}
+	return nil
+}
+
+func (r *memoryRepo) Since(_ context.Context, _ int64, after int64) ([]entities.ScheduleChange, error) {
+	var result []entities.ScheduleChange
+	for _, change := range r.changes {
+		if change.ID > after {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryRepo) Bounds(_ context.Context, _ int64) (int64, int64, error) {
+	var latest int64
+	if len(r.changes) > 0 {
+		latest = r.changes[len(r.changes)-1].ID
+	}
+	return latest, r.prunedThrough, nil
+}
+
+func (r *memoryRepo) Prune(_ context.Context, _ int64, before time.Time) error {
+	kept := r.changes[:0]
+	for _, change := range r.changes {
+		if change.ChangedAt.Before(before) {
+			r.prunedThrough = change.ID
+			continue
+		}
+		kept = append(kept, change)
+	}
+	r.changes = kept
+	return nil
+}
+
+func TestSince(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 9, 2, 8, 20, 0, 0, time.UTC)
+	lesson := func(subject, room string, day int) entities.Lesson {
+		return entities.Lesson{
+			Subject: subject,
+			Type:    "Lecture",
+			Room:    room,
+			Start:   start.AddDate(0, 0, day),
+			End:     start.AddDate(0, 0, day).Add(90 * time.Minute),
+		}
+	}
+	schedule := func(lessons ...entities.Lesson) []entities.DaySchedule {
+		var days []entities.DaySchedule
+		for _, l := range lessons {
+			date := time.Date(l.Start.Year(), l.Start.Month(), l.Start.Day(), 0, 0, 0, 0, time.UTC)
+			days = append(days, entities.DaySchedule{Date: date, Lessons: []entities.Lesson{l}})
+		}
+		return days
+	}
+
+	repo := &memoryRepo{}
+	s := New(repo, time.Hour)
+
+	first := schedule(lesson("Databases", "1404", 0), lesson("Physics", "2202", 1))
+	require.NoError(t, s.Record(ctx, 1, nil, first, time.Time{}))
+
+	token, err := s.Token(ctx, 1)
+	require.NoError(t, err)
+
+	second := schedule(lesson("Databases", "1405", 0), lesson("Algebra", "101", 2))
+	require.NoError(t, s.Record(ctx, 1, first, second, time.Time{}))
+	third := schedule(lesson("Databases", "1405", 0))
+	require.NoError(t, s.Record(ctx, 1, second, third, time.Time{}))
+
+	result, err := s.Since(ctx, 1, token)
+	require.NoError(t, err)
+	require.Len(t, result.Changes, 2, "algebra was added and removed in between")
+
+	assert.Equal(t, entities.ChangeTypeChanged, result.Changes[0].Type)
+	assert.Equal(t, "1405", result.Changes[0].Lesson.Room)
+	assert.Equal(t, entities.ChangeTypeRemoved, result.Changes[1].Type)
+	assert.Nil(t, result.Changes[1].Lesson)
+
+	again, err := s.Since(ctx, 1, result.SyncToken)
+	require.NoError(t, err)
+	assert.Empty(t, again.Changes)
+	assert.Equal(t, result.SyncToken, again.SyncToken)
+
+	t.Run("lessons leaving the period are not removed", func(t *testing.T) {
+		changes := Diff(1, first, nil, start.AddDate(0, 0, 1), time.Now())
+		require.Len(t, changes, 1)
+		assert.Equal(t, entities.LessonUID(1, first[1].Slots()[0]), changes[0].UID)
+	})
+
+	t.Run("pruned token expires", func(t *testing.T) {
+		for i := range repo.changes {
+			repo.changes[i].ChangedAt = time.Now().Add(-2 * time.Hour)
+		}
+		require.NoError(t, s.Record(ctx, 1, third, third, time.Time{}))
+
+		_, err := s.Since(ctx, 1, token)
+		require.ErrorIs(t, err, ErrSyncTokenExpired)
+
+		_, err = s.Since(ctx, 1, "not-a-token")
+		require.ErrorIs(t, err, ErrSyncTokenExpired)
+
+		latest, err := s.Token(ctx, 1)
+		require.NoError(t, err)
+		_, err = s.Since(ctx, 1, latest)
+		require.NoError(t, err)
+	})
+}
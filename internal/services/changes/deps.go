@@ -0,0 +1,15 @@
+package changes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Repo interface {
+	Add(ctx context.Context, changes []entities.ScheduleChange) error
+	Since(ctx context.Context, isu, after int64) ([]entities.ScheduleChange, error)
+	Bounds(ctx context.Context, isu int64) (latest, prunedThrough int64, err error)
+	Prune(ctx context.Context, isu int64, before time.Time) error
+}
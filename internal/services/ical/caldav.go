@@ -4,129 +4,201 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
 
 	ics "github.com/arran4/golang-ical"
+	"github.com/pkg/errors"
 )
 
-type Service struct{}
+// _propConference is the RFC 7986 CONFERENCE property.
+const _propConference ics.ComponentProperty = "CONFERENCE"
 
-// New returns a new iCal service.
-func New() *Service {
-	return &Service{}
+// _minRefreshInterval is the shortest poll interval published to clients. Lessons rarely change
+// within an hour, and every subscriber polling at the cron pace would only load the server.
+const _minRefreshInterval = time.Hour
+
+type Service struct {
+	timeZone             string
+	cancelledGracePeriod time.Duration
+	refreshInterval      time.Duration
+	colors               map[entities.LessonKind]string
+	academic             entities.AcademicCalendar
+}
+
+// New returns a new iCal service rendering times in the given default time zone
+// and keeping removed lessons as cancelled for the given grace period.
+// Clients are asked to poll calendars every refresh interval but at most hourly, lessons are
+// colored by kind and weeks are numbered by the academic calendar.
+func New(timeZone string, cancelledGracePeriod, refreshInterval time.Duration, colors map[entities.LessonKind]string, academic entities.AcademicCalendar) *Service {
+	if refreshInterval > 0 {
+		refreshInterval = max(refreshInterval, _minRefreshInterval)
+	}
+
+	return &Service{
+		timeZone:             timeZone,
+		cancelledGracePeriod: cancelledGracePeriod,
+		refreshInterval:      refreshInterval,
+		colors:               colors,
+		academic:             academic,
+	}
 }
 
 // Generate returns iCalendar data for the given schedule.
-func (s *Service) Generate(_ context.Context, schedule []entities.DaySchedule) (*ics.Calendar, error) {
+func (s *Service) Generate(_ context.Context, schedule []entities.DaySchedule, opts entities.CalendarOptions) (*ics.Calendar, error) {
 	cal := ics.NewCalendar()
 	cal.SetMethod(ics.MethodPublish)
 	cal.SetProductId("-//ITMO Calendar//EN")
 	cal.SetVersion("2.0")
 	cal.SetCalscale("GREGORIAN")
-	cal.SetXWRCalName("ITMO Calendar")
+
+	locale := i18n.Parse(opts.Locale)
+	cal.SetName(i18n.T(locale, i18n.CalendarName))
+	cal.SetDescription(i18n.T(locale, i18n.CalendarDescription))
+	cal.SetXWRCalDesc(i18n.T(locale, i18n.CalendarDescription))
+
+	if s.refreshInterval > 0 {
+		cal.SetRefreshInterval(formatDuration(s.refreshInterval))
+		cal.SetXPublishedTTL(formatDuration(s.refreshInterval))
+	}
+
+	loc, err := s.location(opts.TimeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "load time zone")
+	}
+
+	cal.SetXWRTimezone(loc.String())
+	from, to := scheduleBounds(schedule)
+	addTimezone(cal, loc, from, to)
 
 	now := time.Now().UTC()
 
+	var lessons []scheduledLesson
 	for _, day := range schedule {
-		for _, lesson := range day.Lessons {
-			uid := fmt.Sprintf("%s-%s-%s@itmo-calendar",
-				sanitizeUID(lesson.Subject),
-				sanitizeUID(lesson.TeacherName),
-				lesson.Start.UTC().Format("20060102T150405Z"))
-
-			event := cal.AddEvent(uid)
-
-			event.SetSummary(lesson.Subject)
-			event.SetDtStampTime(now)
-			event.SetCreatedTime(now)
-			event.SetStartAt(lesson.Start.UTC())
-			event.SetEndAt(lesson.End.UTC())
-
-			descParts := []string{
-				lesson.TeacherName,
-				lesson.Type,
-			}
+		slots := day.Slots()
+		for i, lesson := range day.Lessons {
+			lessons = append(lessons, scheduledLesson{slot: slots[i], lesson: lesson})
+		}
+	}
 
-			if lesson.Format != "" {
-				descParts = append(descParts, fmt.Sprintf("Формат: %s", lesson.Format))
-			}
+	templates := compileTemplates(opts.Templates)
 
-			if lesson.Group != "" {
-				descParts = append(descParts, fmt.Sprintf("Группа: %s", lesson.Group))
-			}
+	var series []lessonSeries
+	if opts.CompressRecurrence {
+		series, lessons = compressSeries(lessons, loc)
+	}
 
-			if lesson.Note != "" {
-				descParts = append(descParts, fmt.Sprintf("Заметки: %s", lesson.Note))
+	for _, item := range lessons {
+		identity, ok := opts.Identities[item.slot]
+		if !ok {
+			identity = entities.LessonIdentity{
+				UID: fmt.Sprintf("%s-%s-%s@itmo-calendar",
+					sanitizeUID(item.lesson.Subject),
+					sanitizeUID(item.lesson.TeacherName),
+					item.lesson.Start.UTC().Format("20060102T150405Z")),
+				CreatedAt:  now,
+				ModifiedAt: now,
 			}
+		}
 
-			if lesson.ZoomURL != "" {
-				descParts = append(descParts, fmt.Sprintf("Zoom: %s", lesson.ZoomURL))
-				event.AddProperty(ics.ComponentProperty(ics.PropertyUrl), lesson.ZoomURL)
-			}
+		s.addLessonEvent(cal, identity, item.lesson, loc, opts, templates)
+	}
 
-			event.SetDescription(strings.Join(descParts, "\n"))
+	for _, ls := range series {
+		s.addSeries(cal, ls, loc, opts, templates, now)
+	}
 
-			location := strings.TrimSpace(fmt.Sprintf("%s Аудитория: %s", lesson.Building, lesson.Room))
-			if location != "" {
-				event.SetLocation(location)
-			}
+	if opts.WeekMarkers {
+		s.addWeekMarkers(cal, from, to, loc, locale)
+	}
 
-			event.AddProperty(ics.ComponentProperty(ics.PropertyCategories), lesson.Type)
-			event.SetStatus(ics.ObjectStatusConfirmed)
-			event.SetTimeTransparency(ics.TransparencyOpaque)
-		}
+	if opts.ReauthRequiredSince != nil {
+		s.addReauthEvent(cal, *opts.ReauthRequiredSince, loc, locale)
 	}
 
 	return cal, nil
 }
 
-// Parse converts iCalendar data into DaySchedule entities.
-func (s *Service) Parse(_ context.Context, cal *ics.Calendar) ([]entities.DaySchedule, error) {
-	scheduleMap := make(map[string]*entities.DaySchedule)
+// addLessonEvent adds a VEVENT describing the lesson under the given identity.
+func (s *Service) addLessonEvent(cal *ics.Calendar, identity entities.LessonIdentity, lesson entities.Lesson, loc *time.Location, opts entities.CalendarOptions, templates eventTemplates) *ics.VEvent {
+	event := cal.AddEvent(identity.UID)
+	locale := i18n.Parse(opts.Locale)
+	lessonType := i18n.LessonType(locale, lesson.Type)
+
+	// Templates see times in the calendar's zone.
+	local := lesson
+	local.Start = lesson.Start.In(loc)
+	local.End = lesson.End.In(loc)
+
+	event.SetSummary(renderTemplate(templates.summary, local, lesson.Subject))
+	event.SetDtStampTime(identity.ModifiedAt)
+	event.SetCreatedTime(identity.CreatedAt)
+	event.SetModifiedAt(identity.ModifiedAt)
+	event.SetSequence(identity.Sequence)
+	setLocalTime(&event.ComponentBase, ics.ComponentPropertyDtStart, lesson.Start, loc)
+	setLocalTime(&event.ComponentBase, ics.ComponentPropertyDtEnd, lesson.End, loc)
+
+	descParts := []string{
+		lesson.TeacherName,
+		lessonType,
+	}
 
-	for _, event := range cal.Events() {
-		lesson := entities.Lesson{}
+	if lesson.Format != "" {
+		descParts = append(descParts, fmt.Sprintf("%s: %s", i18n.T(locale, i18n.LabelFormat), lesson.Format))
+	}
 
-		// Extract basic event information
-		if summary := event.GetProperty(ics.ComponentPropertySummary); summary != nil {
-			lesson.Subject = summary.Value
-		}
+	if lesson.Group != "" {
+		descParts = append(descParts, fmt.Sprintf("%s: %s", i18n.T(locale, i18n.LabelGroup), lesson.Group))
+	}
 
-		if dtstart := event.GetProperty(ics.ComponentPropertyDtStart); dtstart != nil {
-			if startTime, err := time.Parse("20060102T150405Z", dtstart.Value); err == nil {
-				lesson.Start = startTime
-			}
-		}
+	if lesson.Note != "" {
+		descParts = append(descParts, fmt.Sprintf("%s: %s", i18n.T(locale, i18n.LabelNote), lesson.Note))
+	}
 
-		if dtend := event.GetProperty(ics.ComponentPropertyDtEnd); dtend != nil {
-			if endTime, err := time.Parse("20060102T150405Z", dtend.Value); err == nil {
-				lesson.End = endTime
-			}
-		}
+	if lesson.ZoomURL != "" {
+		event.AddProperty(_propConference, lesson.ZoomURL,
+			ics.WithValue(string(ics.ValueDataTypeUri)),
+			&ics.KeyValues{Key: "FEATURE", Value: []string{"AUDIO", "VIDEO"}},
+			&ics.KeyValues{Key: "LABEL", Value: []string{"Zoom"}},
+		)
+	}
 
-		// Parse description to extract structured data
-		if desc := event.GetProperty(ics.ComponentPropertyDescription); desc != nil {
-			s.parseDescription(desc.Value, &lesson)
-		}
+	event.SetDescription(renderTemplate(templates.description, local, strings.Join(descParts, "\n")))
 
-		// Extract location information
-		if location := event.GetProperty(ics.ComponentPropertyLocation); location != nil {
-			s.parseLocation(location.Value, &lesson)
-		}
+	location := renderTemplate(templates.location, local,
+		strings.TrimSpace(fmt.Sprintf("%s %s: %s", lesson.Building, i18n.T(locale, i18n.LabelRoom), lesson.Room)))
+	if location != "" {
+		event.SetLocation(location)
+	}
 
-		// Extract type from categories
-		if categories := event.GetProperty(ics.ComponentPropertyCategories); categories != nil {
-			lesson.Type = categories.Value
-		}
+	event.AddProperty(ics.ComponentProperty(ics.PropertyCategories), lessonType)
+	event.SetStatus(ics.ObjectStatusConfirmed)
+	event.SetTimeTransparency(ics.TransparencyOpaque)
+	if color := s.colors[lesson.Kind()]; color != "" {
+		event.SetColor(color)
+	}
+	setLessonProperties(event, lesson)
 
-		// Extract Zoom URL from URL property
-		if url := event.GetProperty(ics.ComponentPropertyUrl); url != nil {
-			lesson.ZoomURL = url.Value
-		}
+	if minutes, ok := entities.ReminderFor(opts.Reminders, lesson); ok {
+		alarm := event.AddAlarm()
+		alarm.SetAction(ics.ActionDisplay)
+		alarm.SetTrigger(fmt.Sprintf("-PT%dM", minutes))
+		alarm.SetProperty(ics.ComponentPropertyDescription, lesson.Subject)
+	}
+
+	return event
+}
 
+// Parse converts iCalendar data into DaySchedule entities. Recurring events are expanded
+// into their occurrences, cancelled lessons, week markers and the re-subscribe event are skipped.
+func (s *Service) Parse(_ context.Context, cal *ics.Calendar) ([]entities.DaySchedule, error) {
+	scheduleMap := make(map[string]*entities.DaySchedule)
+
+	addLesson := func(lesson entities.Lesson) {
 		// Group lessons by date
 		dateKey := lesson.Start.Format("2006-01-02")
 		if _, exists := scheduleMap[dateKey]; !exists {
@@ -140,6 +212,58 @@ func (s *Service) Parse(_ context.Context, cal *ics.Calendar) ([]entities.DaySch
 		scheduleMap[dateKey].Lessons = append(scheduleMap[dateKey].Lessons, lesson)
 	}
 
+	// Overrides of recurring events keyed by UID and RECURRENCE-ID.
+	overrides := make(map[string]map[int64]*ics.VEvent)
+	for _, event := range cal.Events() {
+		recurrenceID := event.GetProperty(ics.ComponentPropertyRecurrenceId)
+		if recurrenceID == nil {
+			continue
+		}
+
+		if t, ok := parseTime(recurrenceID); ok {
+			if overrides[event.Id()] == nil {
+				overrides[event.Id()] = make(map[int64]*ics.VEvent)
+			}
+			overrides[event.Id()][t.Unix()] = event
+		}
+	}
+
+	for _, event := range cal.Events() {
+		if event.HasProperty(ics.ComponentPropertyRecurrenceId) || event.HasProperty(_propWeek) || event.HasProperty(_propReauth) || isCancelled(event) {
+			continue
+		}
+
+		lesson := s.eventLesson(event)
+
+		if !event.HasProperty(ics.ComponentPropertyRrule) {
+			addLesson(lesson)
+			continue
+		}
+
+		// Expand the recurring event, replacing occurrences that have an override.
+		duration := lesson.End.Sub(lesson.Start)
+		for _, start := range expandRecurrence(event, lesson.Start) {
+			if override, ok := overrides[event.Id()][start.Unix()]; ok {
+				if !isCancelled(override) {
+					addLesson(s.eventLesson(override))
+				}
+				continue
+			}
+
+			occurrence := lesson
+			occurrence.Start = start
+			occurrence.End = start.Add(duration)
+			addLesson(occurrence)
+		}
+	}
+
+	for _, day := range scheduleMap {
+		day.Week = s.academic.WeekOf(day.Date)
+		sort.SliceStable(day.Lessons, func(i, j int) bool {
+			return day.Lessons[i].Start.Before(day.Lessons[j].Start)
+		})
+	}
+
 	// Convert map to slice and sort by date
 	var schedule []entities.DaySchedule
 	for _, daySchedule := range scheduleMap {
@@ -158,6 +282,118 @@ func (s *Service) Parse(_ context.Context, cal *ics.Calendar) ([]entities.DaySch
 	return schedule, nil
 }
 
+// eventLesson extracts a lesson from a VEVENT. Structured X-ITMO-* properties are preferred,
+// the human-readable properties are only parsed for events generated without them.
+func (s *Service) eventLesson(event *ics.VEvent) entities.Lesson {
+	lesson := entities.Lesson{}
+
+	if dtstart := event.GetProperty(ics.ComponentPropertyDtStart); dtstart != nil {
+		if startTime, ok := parseTime(dtstart); ok {
+			lesson.Start = startTime
+		}
+	}
+
+	if dtend := event.GetProperty(ics.ComponentPropertyDtEnd); dtend != nil {
+		if endTime, ok := parseTime(dtend); ok {
+			lesson.End = endTime
+		}
+	}
+
+	if parseLessonProperties(event, &lesson) {
+		return lesson
+	}
+
+	// Extract basic event information
+	if summary := event.GetProperty(ics.ComponentPropertySummary); summary != nil {
+		lesson.Subject = summary.Value
+	}
+
+	// Parse description to extract structured data
+	if desc := event.GetProperty(ics.ComponentPropertyDescription); desc != nil {
+		s.parseDescription(desc.Value, &lesson)
+	}
+
+	// Extract location information
+	if location := event.GetProperty(ics.ComponentPropertyLocation); location != nil {
+		s.parseLocation(location.Value, &lesson)
+	}
+
+	// Extract type from categories
+	if categories := event.GetProperty(ics.ComponentPropertyCategories); categories != nil {
+		lesson.Type = categories.Value
+	}
+
+	// Extract Zoom URL from CONFERENCE or the URL property of older calendars
+	if conference := event.GetProperty(_propConference); conference != nil {
+		lesson.ZoomURL = conference.Value
+	} else if url := event.GetProperty(ics.ComponentPropertyUrl); url != nil {
+		lesson.ZoomURL = url.Value
+	}
+
+	return lesson
+}
+
+// location returns the user's time zone, falling back to the service default.
+func (s *Service) location(userTimeZone string) (*time.Location, error) {
+	if userTimeZone != "" {
+		loc, err := time.LoadLocation(userTimeZone)
+		if err == nil {
+			return loc, nil
+		}
+	}
+
+	return time.LoadLocation(s.timeZone)
+}
+
+// scheduleBounds returns the earliest start and the latest end of the schedule's lessons.
+func scheduleBounds(schedule []entities.DaySchedule) (time.Time, time.Time) {
+	var from, to time.Time
+	for _, day := range schedule {
+		for _, lesson := range day.Lessons {
+			if from.IsZero() || lesson.Start.Before(from) {
+				from = lesson.Start
+			}
+			if to.IsZero() || lesson.End.After(to) {
+				to = lesson.End
+			}
+		}
+	}
+
+	if from.IsZero() {
+		from = time.Now()
+		to = from
+	}
+
+	return from, to
+}
+
+// formatDuration formats a duration as the iCalendar DURATION value, e.g. PT30M or P1DT6H.
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+
+	var b strings.Builder
+	b.WriteString("P")
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if d > 0 || days == 0 {
+		b.WriteString("T")
+		if h := d / time.Hour; h > 0 {
+			fmt.Fprintf(&b, "%dH", h)
+		}
+		if m := d % time.Hour / time.Minute; m > 0 {
+			fmt.Fprintf(&b, "%dM", m)
+		}
+		if s := d % time.Minute / time.Second; s > 0 || d == 0 {
+			fmt.Fprintf(&b, "%dS", s)
+		}
+	}
+
+	return b.String()
+}
+
 // parseDescription extracts structured information from event description.
 func (s *Service) parseDescription(description string, lesson *entities.Lesson) {
 	lines := strings.Split(description, "\n")
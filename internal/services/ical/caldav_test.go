@@ -0,0 +1,604 @@
+package ical
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+func testSchedule(t *testing.T) []entities.DaySchedule {
+	t.Helper()
+
+	loc, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	start := time.Date(2024, 9, 2, 8, 20, 0, 0, loc)
+
+	return []entities.DaySchedule{{
+		Date: time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC),
+		Lessons: []entities.Lesson{{
+			Subject:     "Databases",
+			Type:        "Lecture",
+			TeacherName: "Ivanov",
+			Room:        "1404",
+			Building:    "Kronverksky pr., 49",
+			Format:      "Offline",
+			Group:       "P3210",
+			Start:       start,
+			End:         start.Add(90 * time.Minute),
+		}},
+	}}
+}
+
+func TestGenerateTimeZone(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+
+	t.Run("default zone", func(t *testing.T) {
+		cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{})
+		require.NoError(t, err)
+
+		out := cal.Serialize()
+		assert.Contains(t, out, "X-WR-TIMEZONE:Europe/Moscow")
+		assert.Contains(t, out, "BEGIN:VTIMEZONE")
+		assert.Contains(t, out, "TZOFFSETTO:+0300")
+		assert.Contains(t, out, "DTSTART;TZID=Europe/Moscow:20240902T082000")
+	})
+
+	t.Run("user zone with daylight saving", func(t *testing.T) {
+		cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{TimeZone: "Europe/Berlin"})
+		require.NoError(t, err)
+
+		out := cal.Serialize()
+		assert.Contains(t, out, "DTSTART;TZID=Europe/Berlin:20240902T072000")
+		assert.Contains(t, out, "BEGIN:DAYLIGHT")
+		assert.Contains(t, out, "TZOFFSETFROM:+0100")
+		assert.Contains(t, out, "TZOFFSETTO:+0200")
+	})
+
+	t.Run("parse reads local and UTC times", func(t *testing.T) {
+		cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{})
+		require.NoError(t, err)
+
+		parsed, err := s.Parse(ctx, cal)
+		require.NoError(t, err)
+		require.Len(t, parsed, 1)
+		require.Len(t, parsed[0].Lessons, 1)
+		assert.True(t, schedule[0].Lessons[0].Start.Equal(parsed[0].Lessons[0].Start))
+
+		legacy := strings.Replace(cal.Serialize(), "DTSTART;TZID=Europe/Moscow:20240902T082000", "DTSTART:20240902T052000Z", 1)
+		legacyCal, err := ics.ParseCalendar(strings.NewReader(legacy))
+		require.NoError(t, err)
+
+		parsed, err = s.Parse(ctx, legacyCal)
+		require.NoError(t, err)
+		assert.True(t, schedule[0].Lessons[0].Start.Equal(parsed[0].Lessons[0].Start))
+	})
+}
+
+func TestGenerateReminders(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+
+	fifteen, thirty := 15, 30
+	rules := []entities.ReminderRule{
+		{Format: "online"},
+		{LessonType: "lab", MinutesBefore: &thirty},
+		{LessonType: "lect", MinutesBefore: &fifteen},
+	}
+
+	cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{Reminders: rules})
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "BEGIN:VALARM")
+	assert.Contains(t, out, "TRIGGER:-PT15M")
+
+	schedule[0].Lessons[0].Format = "Online"
+	cal, err = s.Generate(ctx, schedule, entities.CalendarOptions{Reminders: rules})
+	require.NoError(t, err)
+	assert.NotContains(t, cal.Serialize(), "BEGIN:VALARM")
+}
+
+func TestCarryCancelled(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+	since := schedule[0].Date.AddDate(0, 0, -1)
+
+	slot := schedule[0].Slots()[0]
+	identity := entities.NewLessonIdentity(1, slot, schedule[0].Lessons[0].Fingerprint(), time.Now().UTC())
+	opts := entities.CalendarOptions{Identities: map[string]entities.LessonIdentity{slot: identity}}
+
+	previous, err := s.Generate(ctx, schedule, opts)
+	require.NoError(t, err)
+
+	cal, err := s.Generate(ctx, nil, opts)
+	require.NoError(t, err)
+
+	cancelled, err := s.CarryCancelled(ctx, cal, previous, nil, opts, since)
+	require.NoError(t, err)
+	assert.Equal(t, []string{identity.UID}, cancelled)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "STATUS:CANCELLED")
+	assert.Contains(t, out, "SUMMARY:"+i18n.T(i18n.RU, i18n.CancelledPrefix)+"Databases")
+	assert.Contains(t, out, "SEQUENCE:1")
+
+	parsed, err := s.Parse(ctx, cal)
+	require.NoError(t, err)
+	assert.Empty(t, parsed)
+
+	t.Run("already cancelled is kept as is", func(t *testing.T) {
+		next, err := s.Generate(ctx, nil, opts)
+		require.NoError(t, err)
+
+		cancelled, err := s.CarryCancelled(ctx, next, cal, nil, opts, since)
+		require.NoError(t, err)
+		assert.Empty(t, cancelled)
+		assert.Len(t, next.Events(), 1)
+		assert.NotContains(t, next.Serialize(), i18n.T(i18n.RU, i18n.CancelledPrefix)+i18n.T(i18n.RU, i18n.CancelledPrefix))
+	})
+
+	t.Run("dropped after grace period", func(t *testing.T) {
+		expired := New("Europe/Moscow", 0, 0, nil, entities.AcademicCalendar{})
+		next, err := expired.Generate(ctx, nil, opts)
+		require.NoError(t, err)
+
+		_, err = expired.CarryCancelled(ctx, next, cal, nil, opts, since)
+		require.NoError(t, err)
+		assert.Empty(t, next.Events())
+	})
+
+	t.Run("lessons before the period are not cancelled", func(t *testing.T) {
+		next, err := s.Generate(ctx, nil, opts)
+		require.NoError(t, err)
+
+		cancelled, err := s.CarryCancelled(ctx, next, previous, nil, opts, since.AddDate(0, 1, 0))
+		require.NoError(t, err)
+		assert.Empty(t, cancelled)
+		assert.Empty(t, next.Events())
+	})
+}
+
+func TestCarryCancelledOccurrences(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Berlin", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	weekly := func(weeks ...int) []entities.DaySchedule {
+		var schedule []entities.DaySchedule
+		for _, week := range weeks {
+			start := time.Date(2024, 9, 2+7*week, 10, 0, 0, 0, loc)
+			schedule = append(schedule, entities.DaySchedule{
+				Date: time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC),
+				Lessons: []entities.Lesson{{
+					Subject:     "Databases",
+					Type:        "Lecture",
+					TeacherName: "Ivanov",
+					Group:       "P3210",
+					Start:       start,
+					End:         start.Add(90 * time.Minute),
+				}},
+			})
+		}
+		return schedule
+	}
+
+	opts := entities.CalendarOptions{CompressRecurrence: true}
+	since := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	previous, err := s.Generate(ctx, weekly(0, 1, 2, 3, 4, 5), opts)
+	require.NoError(t, err)
+
+	// The lecture of week 2 falls inside the series, the one of week 5 after its end.
+	schedule := weekly(0, 1, 3, 4)
+	cal, err := s.Generate(ctx, schedule, opts)
+	require.NoError(t, err)
+	require.Contains(t, cal.Serialize(), "EXDATE;TZID=Europe/Berlin:20240916T100000")
+
+	_, err = s.CarryCancelled(ctx, cal, previous, schedule, opts, since)
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.NotContains(t, out, "EXDATE")
+	assert.Contains(t, out, "RDATE;TZID=Europe/Berlin:20241007T100000")
+	assert.Contains(t, out, "RECURRENCE-ID;TZID=Europe/Berlin:20240916T100000")
+	assert.Contains(t, out, "RECURRENCE-ID;TZID=Europe/Berlin:20241007T100000")
+	assert.Equal(t, 2, strings.Count(out, "STATUS:CANCELLED"))
+	assert.Equal(t, 2, strings.Count(out, "SUMMARY:"+i18n.T(i18n.RU, i18n.CancelledPrefix)+"Databases"))
+	assert.Len(t, cal.Events(), 3)
+
+	for _, event := range cal.Events() {
+		if event.HasProperty(ics.ComponentPropertyRrule) {
+			assert.Equal(t, 1, eventSequence(event))
+		}
+	}
+
+	parsed, err := s.Parse(ctx, cal)
+	require.NoError(t, err)
+	require.Len(t, parsed, len(schedule))
+	for i, day := range schedule {
+		assert.Equal(t, day.Date, parsed[i].Date)
+	}
+
+	t.Run("already cancelled is kept as is", func(t *testing.T) {
+		next, err := s.Generate(ctx, schedule, opts)
+		require.NoError(t, err)
+
+		_, err = s.CarryCancelled(ctx, next, cal, schedule, opts, since)
+		require.NoError(t, err)
+
+		out := next.Serialize()
+		assert.Equal(t, 2, strings.Count(out, "STATUS:CANCELLED"))
+		assert.Contains(t, out, "SEQUENCE:1")
+		assert.NotContains(t, out, "SEQUENCE:2")
+		assert.NotContains(t, out, i18n.T(i18n.RU, i18n.CancelledPrefix)+i18n.T(i18n.RU, i18n.CancelledPrefix))
+	})
+
+	t.Run("restored occurrence bumps the series", func(t *testing.T) {
+		restored := weekly(0, 1, 2, 3, 4)
+		next, err := s.Generate(ctx, restored, opts)
+		require.NoError(t, err)
+
+		_, err = s.CarryCancelled(ctx, next, cal, restored, opts, since)
+		require.NoError(t, err)
+
+		out := next.Serialize()
+		assert.Equal(t, 1, strings.Count(out, "STATUS:CANCELLED"))
+		assert.Contains(t, out, "RECURRENCE-ID;TZID=Europe/Berlin:20241007T100000")
+		assert.Contains(t, out, "SEQUENCE:2")
+	})
+
+	t.Run("dropped after grace period", func(t *testing.T) {
+		expired := New("Europe/Berlin", 0, 0, nil, entities.AcademicCalendar{})
+		next, err := expired.Generate(ctx, schedule, opts)
+		require.NoError(t, err)
+
+		_, err = expired.CarryCancelled(ctx, next, cal, schedule, opts, since)
+		require.NoError(t, err)
+
+		out := next.Serialize()
+		assert.NotContains(t, out, "STATUS:CANCELLED")
+		assert.Contains(t, out, "EXDATE;TZID=Europe/Berlin:20240916T100000")
+		assert.Len(t, next.Events(), 1)
+	})
+}
+
+func TestGenerateRecurrence(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Berlin", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// Bi-weekly lecture across the switch from summer time with one week
+	// skipped and one moved to another room, plus a lab that never repeats.
+	var schedule []entities.DaySchedule
+	for week := 0; week < 12; week += 2 {
+		if week == 6 {
+			continue
+		}
+
+		start := time.Date(2024, 9, 2+7*week, 10, 0, 0, 0, loc)
+		lesson := entities.Lesson{
+			Subject:     "Databases",
+			Type:        "Lecture",
+			TeacherName: "Ivanov",
+			Room:        "1404",
+			Building:    "Kronverksky pr., 49",
+			Group:       "P3210",
+			Start:       start,
+			End:         start.Add(90 * time.Minute),
+		}
+		if week == 8 {
+			lesson.Room = "2202"
+		}
+
+		day := entities.DaySchedule{Date: time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC), Lessons: []entities.Lesson{lesson}}
+		if week == 4 {
+			lab := start.Add(2 * time.Hour)
+			day.Lessons = append(day.Lessons, entities.Lesson{Subject: "Physics", Type: "Lab", Start: lab, End: lab.Add(90 * time.Minute)})
+		}
+		schedule = append(schedule, day)
+	}
+
+	cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{CompressRecurrence: true})
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "RRULE:FREQ=WEEKLY;INTERVAL=2;UNTIL=20241111T090000Z")
+	assert.Contains(t, out, "EXDATE;TZID=Europe/Berlin:20241014T100000")
+	assert.Contains(t, out, "RECURRENCE-ID;TZID=Europe/Berlin:20241028T100000")
+	assert.Len(t, cal.Events(), 3)
+
+	parsed, err := s.Parse(ctx, cal)
+	require.NoError(t, err)
+	require.Len(t, parsed, len(schedule))
+
+	for i, day := range schedule {
+		assert.Equal(t, day.Date, parsed[i].Date)
+		require.Len(t, parsed[i].Lessons, len(day.Lessons))
+
+		for j, lesson := range day.Lessons {
+			got := parsed[i].Lessons[j]
+			assert.Equal(t, lesson.Subject, got.Subject)
+			assert.Equal(t, lesson.Room, got.Room)
+			assert.True(t, lesson.Start.Equal(got.Start), "start of %s on %s", lesson.Subject, day.Date)
+			assert.True(t, lesson.End.Equal(got.End), "end of %s on %s", lesson.Subject, day.Date)
+		}
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, cal.Serialize(), "RRULE")
+		assert.Len(t, cal.Events(), 6)
+	})
+
+	t.Run("collection keeps overrides with their series", func(t *testing.T) {
+		collection := s.Collection(cal)
+		require.Len(t, collection.Objects, 2)
+		assert.Equal(t, "Расписание ИТМО", collection.Name)
+
+		for _, object := range collection.Objects {
+			assert.Contains(t, object.Data, "BEGIN:VTIMEZONE")
+			assert.NotContains(t, object.Data, "METHOD:")
+			assert.NotEmpty(t, object.ETag)
+			if strings.Contains(object.Data, "RRULE") {
+				assert.Equal(t, 2, strings.Count(object.Data, "BEGIN:VEVENT"))
+				assert.True(t, object.Start.Equal(schedule[0].Lessons[0].Start))
+				assert.True(t, object.End.Equal(schedule[len(schedule)-1].Lessons[0].End))
+			}
+		}
+
+		assert.Equal(t, collection.CTag, s.Collection(cal).CTag)
+	})
+}
+
+func TestParseStructuredProperties(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+
+	// Empty teacher and a multi-line note used to shift fields parsed from DESCRIPTION.
+	lesson := &schedule[0].Lessons[0]
+	lesson.TeacherName = ""
+	lesson.Note = "Bring laptops;\nroom may change, see chat"
+	lesson.ZoomURL = "https://zoom.us/j/1"
+
+	cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{})
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "X-ITMO-ROOM:1404")
+	assert.NotContains(t, out, "X-ITMO-TEACHER")
+
+	roundTrip, err := ics.ParseCalendar(strings.NewReader(out))
+	require.NoError(t, err)
+
+	parsed, err := s.Parse(ctx, roundTrip)
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+	require.Len(t, parsed[0].Lessons, 1)
+
+	got := parsed[0].Lessons[0]
+	assert.True(t, lesson.Start.Equal(got.Start))
+	assert.True(t, lesson.End.Equal(got.End))
+	got.Start, got.End = lesson.Start, lesson.End
+	assert.Equal(t, *lesson, got)
+}
+
+func TestGenerateTemplates(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+	schedule[0].Lessons[0].TeacherName = "Ivanov Ivan Ivanovich"
+
+	cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{Templates: entities.EventTemplates{
+		Summary:     `{{ .Type }}: {{ abbr "Data base systems" }} ({{ short .TeacherName }})`,
+		Description: `{{ .Nope }}`,
+		Location:    `{{ .Room }} at {{ .Start.Format "15:04" }}`,
+	}})
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "SUMMARY:Lecture: DBS (Ivanov I. I.)")
+	assert.Contains(t, out, "DESCRIPTION:Ivanov Ivan Ivanovich\\nLecture")
+	assert.Contains(t, out, "LOCATION:1404 at 08:20")
+
+	t.Run("validation", func(t *testing.T) {
+		assert.NoError(t, s.ValidateTemplates(entities.EventTemplates{Summary: `{{ truncate 10 .Subject | upper }}`}))
+		assert.ErrorIs(t, s.ValidateTemplates(entities.EventTemplates{Summary: `{{ .Subject`}), ErrInvalidTemplate)
+		assert.ErrorIs(t, s.ValidateTemplates(entities.EventTemplates{Location: `{{ .Nope }}`}), ErrInvalidTemplate)
+	})
+
+	t.Run("unbounded templates", func(t *testing.T) {
+		slow := `{{range 20000}}{{range 20000}}{{end}}{{end}}x`
+		assert.ErrorIs(t, s.ValidateTemplates(entities.EventTemplates{Summary: slow}), ErrInvalidTemplate)
+		assert.ErrorIs(t, s.ValidateTemplates(entities.EventTemplates{Summary: `{{if .Room}}{{range 3}}x{{end}}{{end}}`}), ErrInvalidTemplate)
+		assert.ErrorIs(t, s.ValidateTemplates(entities.EventTemplates{Summary: `{{define "a"}}x{{end}}{{template "a"}}`}), ErrInvalidTemplate)
+
+		started := time.Now()
+		cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{Templates: entities.EventTemplates{Summary: slow}})
+		require.NoError(t, err)
+		assert.Contains(t, cal.Serialize(), "SUMMARY:Databases")
+		assert.Less(t, time.Since(started), time.Second)
+	})
+
+	t.Run("render timeout", func(t *testing.T) {
+		tpl, err := template.New("slow").Parse(`{{range 2000}}{{range 2000}}{{end}}{{end}}x`)
+		require.NoError(t, err)
+
+		_, err = executeTemplateTimeout(tpl, _sampleLesson, time.Millisecond)
+		assert.Error(t, err)
+	})
+}
+
+func TestGenerateLocale(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+	schedule[0].Lessons[0].Type = "Лекции"
+
+	cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{Locale: "en"})
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "X-WR-CALNAME:ITMO Calendar")
+	assert.Contains(t, out, "DESCRIPTION:Ivanov\\nLecture\\nFormat: Offline\\nGroup: P3210")
+	assert.Contains(t, out, "LOCATION:Kronverksky pr.\\, 49 Room: 1404")
+	assert.Contains(t, out, "X-ITMO-TYPE:Лекции")
+
+	parsed, err := s.Parse(ctx, cal)
+	require.NoError(t, err)
+	assert.Equal(t, "Лекции", parsed[0].Lessons[0].Type)
+}
+
+func TestRefreshInterval(t *testing.T) {
+	ctx := context.Background()
+
+	for interval, want := range map[time.Duration]string{
+		time.Minute:                "PT1H",
+		time.Hour:                  "PT1H",
+		90 * time.Minute:           "PT1H30M",
+		24*time.Hour + time.Second: "P1DT1S",
+	} {
+		cal, err := New("Europe/Moscow", 0, interval, nil, entities.AcademicCalendar{}).Generate(ctx, testSchedule(t), entities.CalendarOptions{})
+		require.NoError(t, err)
+
+		out := cal.Serialize()
+		assert.Contains(t, out, "REFRESH-INTERVAL;VALUE=DURATION:"+want+"\n", interval)
+		assert.Contains(t, out, "X-PUBLISHED-TTL:"+want+"\n", interval)
+	}
+
+	cal, err := New("Europe/Moscow", 0, 0, nil, entities.AcademicCalendar{}).Generate(ctx, testSchedule(t), entities.CalendarOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, cal.Serialize(), "REFRESH-INTERVAL")
+}
+
+func TestGenerateRFC7986(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Moscow", 7*24*time.Hour, 30*time.Minute, map[entities.LessonKind]string{
+		entities.LessonKindLecture: "royalblue",
+	}, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+	schedule[0].Lessons[0].Type = "Лекции"
+	schedule[0].Lessons[0].ZoomURL = "https://zoom.us/j/1"
+
+	cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{})
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	assert.Contains(t, out, "X-PUBLISHED-TTL:PT1H")
+	assert.Contains(t, out, "NAME:Расписание ИТМО")
+	assert.Contains(t, out, "COLOR:royalblue")
+	assert.Contains(t, out, "CONFERENCE;FEATURE=AUDIO,VIDEO;LABEL=Zoom;VALUE=URI:https://zoom.us/j/1")
+	assert.NotContains(t, out, "Zoom: ")
+
+	assert.Equal(t, "P1DT6H", formatDuration(30*time.Hour))
+	assert.Equal(t, "PT0S", formatDuration(0))
+}
+
+func TestGenerateWeekMarkers(t *testing.T) {
+	ctx := context.Background()
+	academic, err := entities.ParseAcademicCalendar([]string{"2024-09-02"}, []string{"2024-09-16/2024-09-22"}, nil)
+	require.NoError(t, err)
+
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, academic)
+	schedule := testSchedule(t)
+	later := schedule[0]
+	later.Date = later.Date.AddDate(0, 0, 14)
+	later.Lessons = []entities.Lesson{later.Lessons[0]}
+	later.Lessons[0].Start = later.Lessons[0].Start.AddDate(0, 0, 14)
+	later.Lessons[0].End = later.Lessons[0].End.AddDate(0, 0, 14)
+	schedule = append(schedule, later)
+
+	cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{WeekMarkers: true, Locale: "en"})
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "SUMMARY:Week 1 (odd)")
+	assert.Contains(t, out, "SUMMARY:Week 2 (even)")
+	assert.Contains(t, out, "SUMMARY:Holidays")
+	assert.Contains(t, out, "DTSTART;VALUE=DATE:20240909")
+
+	parsed, err := s.Parse(ctx, cal)
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	require.Len(t, parsed[0].Lessons, 1)
+	require.NotNil(t, parsed[0].Week)
+	assert.Equal(t, 1, parsed[0].Week.Number)
+	assert.Equal(t, entities.WeekPeriodHoliday, parsed[1].Week.Period)
+
+	t.Run("last week on an earlier weekday", func(t *testing.T) {
+		// From a Wednesday to the Monday of the following week.
+		schedule := testSchedule(t)
+		first := schedule[0]
+		first.Date = first.Date.AddDate(0, 0, 2)
+		first.Lessons = []entities.Lesson{first.Lessons[0]}
+		first.Lessons[0].Start = first.Lessons[0].Start.AddDate(0, 0, 2)
+		first.Lessons[0].End = first.Lessons[0].End.AddDate(0, 0, 2)
+		last := schedule[0]
+		last.Date = last.Date.AddDate(0, 0, 7)
+		last.Lessons = []entities.Lesson{last.Lessons[0]}
+		last.Lessons[0].Start = last.Lessons[0].Start.AddDate(0, 0, 7)
+		last.Lessons[0].End = last.Lessons[0].End.AddDate(0, 0, 7)
+
+		cal, err := s.Generate(ctx, []entities.DaySchedule{first, last}, entities.CalendarOptions{WeekMarkers: true, Locale: "en"})
+		require.NoError(t, err)
+
+		out := cal.Serialize()
+		assert.Contains(t, out, "SUMMARY:Week 1 (odd)")
+		assert.Contains(t, out, "SUMMARY:Week 2 (even)")
+		assert.Contains(t, out, "DTSTART;VALUE=DATE:20240902")
+		assert.Contains(t, out, "DTSTART;VALUE=DATE:20240909")
+	})
+}
+
+func TestFreeBusy(t *testing.T) {
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+	from := schedule[0].Date
+	fb := entities.NewFreeBusy(1, schedule, from, from.AddDate(0, 0, 1), 10*time.Minute, 0)
+
+	out := s.FreeBusy(fb).Serialize()
+	assert.Contains(t, out, "BEGIN:VFREEBUSY")
+	assert.Contains(t, out, "DTSTART:20240902T000000Z")
+	assert.Contains(t, out, "FREEBUSY;FBTYPE=BUSY:20240902T051000Z/20240902T065000Z")
+	assert.NotContains(t, out, "Databases")
+	assert.NotContains(t, out, "Ivanov")
+}
+
+func TestGenerateReauth(t *testing.T) {
+	ctx := context.Background()
+	s := New("Europe/Moscow", 7*24*time.Hour, 0, nil, entities.AcademicCalendar{})
+	schedule := testSchedule(t)
+	since := time.Date(2025, 3, 10, 21, 30, 0, 0, time.UTC)
+
+	cal, err := s.Generate(ctx, schedule, entities.CalendarOptions{Locale: "en", ReauthRequiredSince: &since})
+	require.NoError(t, err)
+
+	out := cal.Serialize()
+	assert.Contains(t, out, "UID:reauth@itmo-calendar")
+	assert.Contains(t, out, "X-ITMO-REAUTH:true")
+	assert.Contains(t, out, "DTSTART;VALUE=DATE:20250311")
+
+	parsed, err := s.Parse(ctx, cal)
+	require.NoError(t, err)
+	assert.Equal(t, len(schedule), len(parsed))
+}
@@ -0,0 +1,313 @@
+package ical
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+// CarryCancelled copies lessons that disappeared from the schedule since previous was generated
+// into cal as cancelled events and returns the UIDs of the lessons cancelled by this call.
+// Only events of known lesson identities starting after since are considered, so lessons that
+// merely left the requested period are dropped silently. Cancelled events are kept for the
+// grace period counted from their cancellation. Events of previous are moved into cal.
+func (s *Service) CarryCancelled(_ context.Context, cal, previous *ics.Calendar, schedule []entities.DaySchedule, opts entities.CalendarOptions, since time.Time) ([]string, error) {
+	known := make(map[string]struct{}, len(opts.Identities))
+	for _, identity := range opts.Identities {
+		known[identity.UID] = struct{}{}
+	}
+
+	// Lessons folded into a recurring event keep their identity but have no VEVENT of their own.
+	present := make(map[string]struct{})
+	for _, event := range cal.Events() {
+		present[event.Id()] = struct{}{}
+	}
+	for _, day := range schedule {
+		for _, slot := range day.Slots() {
+			if identity, ok := opts.Identities[slot]; ok {
+				present[identity.UID] = struct{}{}
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	prefix := i18n.T(i18n.Parse(opts.Locale), i18n.CancelledPrefix)
+	var cancelled []string
+
+	for _, event := range previous.Events() {
+		uid := event.Id()
+		if _, ok := present[uid]; ok {
+			continue
+		}
+		if _, ok := known[uid]; !ok {
+			continue
+		}
+
+		dtstart := event.GetProperty(ics.ComponentPropertyDtStart)
+		if dtstart == nil {
+			continue
+		}
+		if start, ok := parseTime(dtstart); !ok || start.Before(since) {
+			continue
+		}
+
+		if isCancelled(event) {
+			modifiedAt, err := event.GetLastModifiedAt()
+			if err != nil || now.Sub(modifiedAt) > s.cancelledGracePeriod {
+				continue
+			}
+
+			cal.AddVEvent(event)
+			continue
+		}
+
+		markCancelled(event, now, prefix)
+		cal.AddVEvent(event)
+		cancelled = append(cancelled, uid)
+	}
+
+	s.carryCancelledOccurrences(cal, previous, since, now, prefix)
+
+	return cancelled, nil
+}
+
+// carryCancelledOccurrences adds a cancelled RECURRENCE-ID override for every occurrence that
+// a recurring event of cal had in previous but lost since, so clients show it as cancelled instead
+// of dropping it with an EXDATE. The occurrence is brought back into the recurrence set, and the
+// SEQUENCE of the series never goes back and is bumped whenever its cancelled occurrences change.
+func (s *Service) carryCancelledOccurrences(cal, previous *ics.Calendar, since, now time.Time, prefix string) {
+	masters := make(map[string]*ics.VEvent)
+	for _, event := range cal.Events() {
+		if event.HasProperty(ics.ComponentPropertyRrule) && !event.HasProperty(ics.ComponentPropertyRecurrenceId) {
+			masters[event.Id()] = event
+		}
+	}
+	if len(masters) == 0 {
+		return
+	}
+
+	previousMasters := make(map[string]*ics.VEvent)
+	previousOverrides := make(map[string]map[int64]*ics.VEvent)
+	for _, event := range previous.Events() {
+		uid := event.Id()
+		if _, ok := masters[uid]; !ok {
+			continue
+		}
+
+		if recurrenceID := event.GetProperty(ics.ComponentPropertyRecurrenceId); recurrenceID != nil {
+			if t, ok := parseTime(recurrenceID); ok {
+				if previousOverrides[uid] == nil {
+					previousOverrides[uid] = make(map[int64]*ics.VEvent)
+				}
+				previousOverrides[uid][t.Unix()] = event
+			}
+			continue
+		}
+		if event.HasProperty(ics.ComponentPropertyRrule) {
+			previousMasters[uid] = event
+		}
+	}
+
+	uids := make([]string, 0, len(previousMasters))
+	for uid := range previousMasters {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+
+	for _, uid := range uids {
+		master, prev := masters[uid], previousMasters[uid]
+
+		start, ok := parseTime(master.GetProperty(ics.ComponentPropertyDtStart))
+		if !ok {
+			continue
+		}
+		present := make(map[int64]struct{})
+		for _, occurrence := range expandRecurrence(master, start) {
+			present[occurrence.Unix()] = struct{}{}
+		}
+
+		changed := false
+		for _, occurrence := range previousOccurrences(prev, previousOverrides[uid]) {
+			override := previousOverrides[uid][occurrence.Unix()]
+			if _, ok := present[occurrence.Unix()]; ok {
+				// A cancelled occurrence is back in the schedule.
+				changed = changed || (override != nil && isCancelled(override))
+				continue
+			}
+			if occurrence.Before(since) {
+				continue
+			}
+
+			if override == nil {
+				override = occurrenceEvent(prev, occurrence)
+			} else {
+				override = cloneEvent(override)
+			}
+
+			if isCancelled(override) {
+				modifiedAt, err := override.GetLastModifiedAt()
+				if err != nil || now.Sub(modifiedAt) > s.cancelledGracePeriod {
+					continue
+				}
+			} else {
+				markCancelled(override, now, prefix)
+				changed = true
+			}
+
+			restoreOccurrence(master, occurrence)
+			cal.AddVEvent(override)
+		}
+
+		sequence := max(eventSequence(master), eventSequence(prev))
+		if changed {
+			sequence++
+			master.SetDtStampTime(now)
+			master.SetModifiedAt(now)
+		}
+		master.SetSequence(sequence)
+	}
+}
+
+// previousOccurrences returns the starts of the occurrences of a recurring event
+// together with the occurrences it has overrides for, in order.
+func previousOccurrences(master *ics.VEvent, overrides map[int64]*ics.VEvent) []time.Time {
+	dtstart := master.GetProperty(ics.ComponentPropertyDtStart)
+	if dtstart == nil {
+		return nil
+	}
+	start, ok := parseTime(dtstart)
+	if !ok {
+		return nil
+	}
+
+	occurrences := expandRecurrence(master, start)
+	seen := make(map[int64]struct{}, len(occurrences))
+	for _, occurrence := range occurrences {
+		seen[occurrence.Unix()] = struct{}{}
+	}
+
+	for _, override := range overrides {
+		recurrenceID, ok := parseTime(override.GetProperty(ics.ComponentPropertyRecurrenceId))
+		if _, dup := seen[recurrenceID.Unix()]; !ok || dup {
+			continue
+		}
+		seen[recurrenceID.Unix()] = struct{}{}
+		occurrences = append(occurrences, recurrenceID)
+	}
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].Before(occurrences[j]) })
+
+	return occurrences
+}
+
+// occurrenceEvent returns a RECURRENCE-ID override repeating the master at the given occurrence.
+func occurrenceEvent(master *ics.VEvent, occurrence time.Time) *ics.VEvent {
+	event := cloneEvent(master)
+	event.Properties = withoutProperties(event.Properties, func(prop ics.IANAProperty) bool {
+		switch ics.ComponentProperty(prop.IANAToken) {
+		case ics.ComponentPropertyRrule, ics.ComponentPropertyExdate, ics.ComponentPropertyRdate:
+			return true
+		}
+		return false
+	})
+
+	start, _ := parseTime(master.GetProperty(ics.ComponentPropertyDtStart))
+	duration := time.Duration(0)
+	if dtend := master.GetProperty(ics.ComponentPropertyDtEnd); dtend != nil {
+		if end, ok := parseTime(dtend); ok {
+			duration = end.Sub(start)
+		}
+	}
+
+	loc := occurrence.Location()
+	setLocalTime(&event.ComponentBase, ics.ComponentPropertyDtStart, occurrence, loc)
+	setLocalTime(&event.ComponentBase, ics.ComponentPropertyDtEnd, occurrence.Add(duration), loc)
+	setLocalTime(&event.ComponentBase, ics.ComponentPropertyRecurrenceId, occurrence, loc)
+
+	return event
+}
+
+// restoreOccurrence makes the occurrence part of the recurrence set of the master again,
+// dropping its EXDATE or adding an RDATE when the rule no longer generates it.
+func restoreOccurrence(master *ics.VEvent, occurrence time.Time) {
+	excluded := false
+	master.Properties = withoutProperties(master.Properties, func(prop ics.IANAProperty) bool {
+		if ics.ComponentProperty(prop.IANAToken) != ics.ComponentPropertyExdate {
+			return false
+		}
+		t, ok := parseTime(&prop)
+		excluded = excluded || (ok && t.Equal(occurrence))
+		return ok && t.Equal(occurrence)
+	})
+	if excluded {
+		return
+	}
+
+	loc := occurrence.Location()
+	master.AddRdate(occurrence.In(loc).Format(_localTimeFormat), ics.WithTZID(loc.String()))
+}
+
+// withoutProperties returns the properties the drop function rejects, leaving props untouched.
+func withoutProperties(props []ics.IANAProperty, drop func(ics.IANAProperty) bool) []ics.IANAProperty {
+	kept := make([]ics.IANAProperty, 0, len(props))
+	for _, prop := range props {
+		if !drop(prop) {
+			kept = append(kept, prop)
+		}
+	}
+
+	return kept
+}
+
+// cloneEvent returns a copy of the event that can be changed without touching the original.
+func cloneEvent(event *ics.VEvent) *ics.VEvent {
+	clone := *event
+	clone.Properties = append([]ics.IANAProperty(nil), event.Properties...)
+	clone.Components = append([]ics.Component(nil), event.Components...)
+
+	return &clone
+}
+
+// eventSequence returns the SEQUENCE of the event, zero when it has none.
+func eventSequence(event *ics.VEvent) int {
+	sequence := 0
+	if prop := event.GetProperty(ics.ComponentPropertySequence); prop != nil {
+		sequence, _ = strconv.Atoi(prop.Value)
+	}
+
+	return sequence
+}
+
+// markCancelled turns a lesson event into a cancelled one, prefixing its summary.
+func markCancelled(event *ics.VEvent, now time.Time, prefix string) {
+	if summary := event.GetProperty(ics.ComponentPropertySummary); summary != nil {
+		event.SetSummary(prefix + summary.Value)
+	}
+
+	event.SetSequence(eventSequence(event) + 1)
+	event.SetDtStampTime(now)
+	event.SetModifiedAt(now)
+	event.SetStatus(ics.ObjectStatusCancelled)
+	event.SetTimeTransparency(ics.TransparencyTransparent)
+
+	// Cancelled lessons should not ring.
+	components := event.Components[:0]
+	for _, component := range event.Components {
+		if _, ok := component.(*ics.VAlarm); !ok {
+			components = append(components, component)
+		}
+	}
+	event.Components = components
+}
+
+// isCancelled reports whether the event has STATUS:CANCELLED.
+func isCancelled(event *ics.VEvent) bool {
+	status := event.GetProperty(ics.ComponentPropertyStatus)
+	return status != nil && strings.EqualFold(status.Value, string(ics.ObjectStatusCancelled))
+}
@@ -0,0 +1,33 @@
+package ical
+
+import (
+	"fmt"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// FreeBusy renders the availability as a calendar with a single VFREEBUSY component.
+// Only the busy periods are published, nothing about the lessons themselves.
+func (s *Service) FreeBusy(fb entities.FreeBusy) *ics.Calendar {
+	cal := ics.NewCalendar()
+	cal.SetMethod(ics.MethodPublish)
+	cal.SetProductId("-//ITMO Calendar//EN")
+	cal.SetVersion("2.0")
+
+	busy := cal.AddBusy(fmt.Sprintf("freebusy-%d-%s@itmo-calendar", fb.ISU, fb.From.UTC().Format(_utcTimeFormat)))
+	busy.SetDtStampTime(time.Now())
+	busy.SetStartAt(fb.From)
+	busy.SetEndAt(fb.To)
+	for _, period := range fb.Busy {
+		busy.AddProperty(
+			ics.ComponentPropertyFreebusy,
+			period.Start.UTC().Format(_utcTimeFormat)+"/"+period.End.UTC().Format(_utcTimeFormat),
+			&ics.KeyValues{Key: string(ics.ParameterFbtype), Value: []string{string(ics.FreeBusyTimeTypeBusy)}},
+		)
+	}
+
+	return cal
+}
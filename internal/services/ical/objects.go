@@ -0,0 +1,140 @@
+package ical
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+const _dateFormat = "20060102"
+
+// Collection splits a calendar into CalDAV objects, one per UID. Every object carries the
+// time zones of the calendar and recurring events keep their overrides.
+func (s *Service) Collection(cal *ics.Calendar) entities.CalendarCollection {
+	var (
+		timezones []ics.Component
+		uids      []string
+		events    = make(map[string][]*ics.VEvent)
+	)
+
+	for _, component := range cal.Components {
+		switch c := component.(type) {
+		case *ics.VTimezone:
+			timezones = append(timezones, c)
+		case *ics.VEvent:
+			uid := c.Id()
+			if _, ok := events[uid]; !ok {
+				uids = append(uids, uid)
+			}
+			events[uid] = append(events[uid], c)
+		}
+	}
+	sort.Strings(uids)
+
+	collection := entities.CalendarCollection{
+		Name:        calendarProperty(cal, ics.PropertyName, ics.PropertyXWRCalName),
+		Description: calendarProperty(cal, ics.PropertyDescription, ics.PropertyXWRCalDesc),
+		Objects:     make([]entities.CalendarObject, 0, len(uids)),
+	}
+
+	ctag := sha256.New()
+	for _, uid := range uids {
+		object := calendarObject(cal, uid, timezones, events[uid])
+		ctag.Write([]byte(object.ETag))
+		collection.Objects = append(collection.Objects, object)
+	}
+	collection.CTag = hex.EncodeToString(ctag.Sum(nil)[:16])
+
+	return collection
+}
+
+// calendarObject builds a standalone calendar holding the events of one UID.
+func calendarObject(cal *ics.Calendar, uid string, timezones []ics.Component, events []*ics.VEvent) entities.CalendarObject {
+	object := &ics.Calendar{}
+	// A calendar object resource must not carry METHOD, so only the required properties are kept.
+	for _, prop := range cal.CalendarProperties {
+		switch ics.Property(prop.IANAToken) {
+		case ics.PropertyVersion, ics.PropertyProductId, ics.PropertyCalscale:
+			object.CalendarProperties = append(object.CalendarProperties, prop)
+		}
+	}
+	object.Components = append(object.Components, timezones...)
+
+	var start, end time.Time
+	for _, event := range events {
+		object.Components = append(object.Components, event)
+
+		from, to, ok := eventBounds(event)
+		if !ok {
+			continue
+		}
+		if start.IsZero() || from.Before(start) {
+			start = from
+		}
+		if to.After(end) {
+			end = to
+		}
+	}
+
+	data := object.Serialize()
+	sum := sha256.Sum256([]byte(data))
+
+	return entities.CalendarObject{
+		UID:   uid,
+		Data:  data,
+		ETag:  `"` + hex.EncodeToString(sum[:16]) + `"`,
+		Start: start,
+		End:   end,
+	}
+}
+
+// eventBounds returns the start of the first and the end of the last occurrence of an event.
+func eventBounds(event *ics.VEvent) (time.Time, time.Time, bool) {
+	start, ok := parseDateOrTime(event.GetProperty(ics.ComponentPropertyDtStart))
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+
+	end, ok := parseDateOrTime(event.GetProperty(ics.ComponentPropertyDtEnd))
+	if !ok || end.Before(start) {
+		end = start
+	}
+
+	starts := expandRecurrence(event, start)
+	if len(starts) == 0 {
+		return start, end, true
+	}
+
+	return starts[0], starts[len(starts)-1].Add(end.Sub(start)), true
+}
+
+// parseDateOrTime reads a DATE-TIME property or a DATE one as midnight UTC.
+func parseDateOrTime(prop *ics.IANAProperty) (time.Time, bool) {
+	if prop == nil {
+		return time.Time{}, false
+	}
+	if t, ok := parseTime(prop); ok {
+		return t, true
+	}
+
+	t, err := time.Parse(_dateFormat, prop.Value)
+	return t, err == nil
+}
+
+// calendarProperty returns the value of the first of the properties the calendar has.
+func calendarProperty(cal *ics.Calendar, names ...ics.Property) string {
+	for _, name := range names {
+		for _, prop := range cal.CalendarProperties {
+			if prop.IANAToken == string(name) {
+				return prop.Value
+			}
+		}
+	}
+
+	return ""
+}
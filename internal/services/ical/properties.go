@@ -0,0 +1,55 @@
+package ical
+
+import (
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+// _propSubject marks events carrying structured lesson fields, it is emitted even when empty.
+const _propSubject ics.ComponentProperty = "X-ITMO-SUBJECT"
+
+// _lessonProperties maps lesson fields to the X- properties holding their raw values,
+// so lessons survive the round trip regardless of how SUMMARY and DESCRIPTION are rendered.
+var _lessonProperties = []struct {
+	name  ics.ComponentProperty
+	field func(lesson *entities.Lesson) *string
+}{
+	{_propSubject, func(l *entities.Lesson) *string { return &l.Subject }},
+	{"X-ITMO-TYPE", func(l *entities.Lesson) *string { return &l.Type }},
+	{"X-ITMO-TEACHER", func(l *entities.Lesson) *string { return &l.TeacherName }},
+	{"X-ITMO-ROOM", func(l *entities.Lesson) *string { return &l.Room }},
+	{"X-ITMO-BUILDING", func(l *entities.Lesson) *string { return &l.Building }},
+	{"X-ITMO-FORMAT", func(l *entities.Lesson) *string { return &l.Format }},
+	{"X-ITMO-GROUP", func(l *entities.Lesson) *string { return &l.Group }},
+	{"X-ITMO-NOTE", func(l *entities.Lesson) *string { return &l.Note }},
+	{"X-ITMO-ZOOM-URL", func(l *entities.Lesson) *string { return &l.ZoomURL }},
+}
+
+// setLessonProperties emits the raw lesson fields as X-ITMO-* properties.
+func setLessonProperties(event *ics.VEvent, lesson entities.Lesson) {
+	for _, prop := range _lessonProperties {
+		value := *prop.field(&lesson)
+		if value == "" && prop.name != _propSubject {
+			continue
+		}
+
+		event.SetProperty(prop.name, value)
+	}
+}
+
+// parseLessonProperties fills the lesson from X-ITMO-* properties.
+// Returns false if the event was generated without them.
+func parseLessonProperties(event *ics.VEvent, lesson *entities.Lesson) bool {
+	if !event.HasProperty(_propSubject) {
+		return false
+	}
+
+	for _, prop := range _lessonProperties {
+		if p := event.GetProperty(prop.name); p != nil {
+			*prop.field(lesson) = p.Value
+		}
+	}
+
+	return true
+}
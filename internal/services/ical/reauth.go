@@ -0,0 +1,26 @@
+package ical
+
+import (
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+// _propReauth marks the event asking the user to subscribe again.
+const _propReauth ics.ComponentProperty = "X-ITMO-REAUTH"
+
+// addReauthEvent adds an all-day event on the day syncing stopped, asking the user to subscribe again.
+func (s *Service) addReauthEvent(cal *ics.Calendar, since time.Time, loc *time.Location, locale i18n.Locale) {
+	day := civilDate(since, loc)
+
+	event := cal.AddEvent("reauth@itmo-calendar")
+	event.SetSummary(i18n.T(locale, i18n.ReauthSummary))
+	event.SetDescription(i18n.T(locale, i18n.ReauthDescription))
+	event.SetDtStampTime(since)
+	event.SetAllDayStartAt(day)
+	event.SetAllDayEndAt(day.AddDate(0, 0, 1))
+	event.SetTimeTransparency(ics.TransparencyTransparent)
+	event.SetProperty(_propReauth, "true")
+}
@@ -0,0 +1,381 @@
+package ical
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+const (
+	// _minSeriesLength is the least number of lessons worth a recurring event.
+	_minSeriesLength = 3
+	// _maxOccurrences bounds the expansion of recurrence rules on parsing.
+	_maxOccurrences = 1000
+)
+
+// scheduledLesson is a lesson together with its slot in the schedule.
+type scheduledLesson struct {
+	slot   string
+	lesson entities.Lesson
+}
+
+// seriesShape holds everything but the date that must match for lessons to be
+// plain occurrences of one recurring event.
+type seriesShape struct {
+	weekday     time.Weekday
+	clock       int // minutes since local midnight
+	duration    time.Duration
+	teacherName string
+	room        string
+	building    string
+	format      string
+	note        string
+	zoomURL     string
+}
+
+func shapeOf(lesson entities.Lesson, loc *time.Location) seriesShape {
+	start := lesson.Start.In(loc)
+
+	return seriesShape{
+		weekday:     start.Weekday(),
+		clock:       start.Hour()*60 + start.Minute(),
+		duration:    lesson.End.Sub(lesson.Start),
+		teacherName: lesson.TeacherName,
+		room:        lesson.Room,
+		building:    lesson.Building,
+		format:      lesson.Format,
+		note:        lesson.Note,
+		zoomURL:     lesson.ZoomURL,
+	}
+}
+
+// seriesOccurrence is a lesson claimed by a series.
+type seriesOccurrence struct {
+	// recurrenceID is the start of the occurrence as generated by the rule.
+	recurrenceID time.Time
+	item         scheduledLesson
+	// override is set when the lesson deviates from the series and needs its own VEVENT.
+	override bool
+}
+
+// lessonSeries is a lesson repeating weekly or bi-weekly.
+type lessonSeries struct {
+	// key identifies the series independently of the period it is generated for.
+	key         string
+	template    entities.Lesson
+	interval    int
+	occurrences []seriesOccurrence
+	exdates     []time.Time
+}
+
+// compressSeries groups lessons repeating every one or two weeks into series.
+// Lessons of the same subject, type and group make a series when at least two of them share
+// weekday, time and attributes. Other lessons of the group falling on the series' dates become
+// overrides. Lessons left out of any series are returned in their original order.
+func compressSeries(lessons []scheduledLesson, loc *time.Location) ([]lessonSeries, []scheduledLesson) {
+	groups := make(map[string][]scheduledLesson)
+	var keys []string
+
+	for _, item := range lessons {
+		key := strings.Join([]string{item.lesson.Subject, item.lesson.Type, item.lesson.Group}, "|")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], item)
+	}
+
+	var series []lessonSeries
+	claimed := make(map[string]struct{})
+
+	for _, key := range keys {
+		ls, ok := buildSeries(key, groups[key], loc)
+		if !ok {
+			continue
+		}
+
+		for _, occurrence := range ls.occurrences {
+			claimed[occurrence.item.slot] = struct{}{}
+		}
+		series = append(series, ls)
+	}
+
+	rest := make([]scheduledLesson, 0, len(lessons))
+	for _, item := range lessons {
+		if _, ok := claimed[item.slot]; !ok {
+			rest = append(rest, item)
+		}
+	}
+
+	return series, rest
+}
+
+// buildSeries tries to describe a group of lessons as a single series.
+func buildSeries(key string, group []scheduledLesson, loc *time.Location) (lessonSeries, bool) {
+	if len(group) < _minSeriesLength {
+		return lessonSeries{}, false
+	}
+
+	group = append([]scheduledLesson(nil), group...)
+	sort.SliceStable(group, func(i, j int) bool {
+		return group[i].lesson.Start.Before(group[j].lesson.Start)
+	})
+
+	// The most frequent shape is the series template, the earliest one wins a tie.
+	counts := make(map[seriesShape]int)
+	var template seriesShape
+	for _, item := range group {
+		shape := shapeOf(item.lesson, loc)
+		counts[shape]++
+		if counts[shape] > counts[template] {
+			template = shape
+		}
+	}
+	if counts[template] < 2 {
+		return lessonSeries{}, false
+	}
+
+	var anchor time.Time
+	interval := 0
+	for _, item := range group {
+		if shapeOf(item.lesson, loc) != template {
+			continue
+		}
+
+		date := civilDate(item.lesson.Start, loc)
+		if anchor.IsZero() {
+			anchor = date
+			continue
+		}
+		interval = gcd(interval, weeksBetween(anchor, date))
+	}
+	if interval != 1 && interval != 2 {
+		return lessonSeries{}, false
+	}
+
+	occurrence := func(date time.Time) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), template.clock/60, template.clock%60, 0, 0, loc)
+	}
+
+	byDate := make(map[time.Time]seriesOccurrence)
+	claim := func(item scheduledLesson, override bool) {
+		date := civilDate(item.lesson.Start, loc)
+		if date.Weekday() != template.weekday || weeksBetween(anchor, date)%interval != 0 {
+			return
+		}
+		if _, ok := byDate[date]; ok {
+			return
+		}
+
+		byDate[date] = seriesOccurrence{
+			recurrenceID: occurrence(date),
+			item:         item,
+			override:     override,
+		}
+	}
+
+	// Plain occurrences claim their dates first, deviations take the remaining ones.
+	for _, item := range group {
+		if shapeOf(item.lesson, loc) == template {
+			claim(item, false)
+		}
+	}
+	for _, item := range group {
+		if shapeOf(item.lesson, loc) != template {
+			claim(item, true)
+		}
+	}
+
+	dates := make([]time.Time, 0, len(byDate))
+	for date := range byDate {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	first, last := dates[0], dates[len(dates)-1]
+
+	ls := lessonSeries{
+		key:      fmt.Sprintf("%s|%d|%d|%d|%d", key, template.weekday, template.clock, interval, weeksBetween(_epoch, first)%interval),
+		interval: interval,
+	}
+
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 7*interval) {
+		if occ, ok := byDate[date]; ok {
+			ls.occurrences = append(ls.occurrences, occ)
+		} else {
+			ls.exdates = append(ls.exdates, occurrence(date))
+		}
+	}
+
+	// A sparse series is harder to read than the lessons themselves.
+	if len(ls.occurrences) < _minSeriesLength || len(ls.exdates) >= len(ls.occurrences) {
+		return lessonSeries{}, false
+	}
+
+	for _, occ := range ls.occurrences {
+		if !occ.override {
+			ls.template = occ.item.lesson
+			break
+		}
+	}
+	ls.template.Start = occurrence(first)
+	ls.template.End = ls.template.Start.Add(template.duration)
+
+	return ls, true
+}
+
+// addSeries adds the master VEVENT of the series and a RECURRENCE-ID override for every deviation.
+func (s *Service) addSeries(cal *ics.Calendar, ls lessonSeries, loc *time.Location, opts entities.CalendarOptions, templates eventTemplates, now time.Time) {
+	var master entities.LessonIdentity
+	for _, occ := range ls.occurrences {
+		identity, ok := opts.Identities[occ.item.slot]
+		if !ok {
+			continue
+		}
+
+		if master.UID == "" {
+			master = identity
+			continue
+		}
+		if identity.Sequence > master.Sequence {
+			master.Sequence = identity.Sequence
+		}
+		if identity.CreatedAt.Before(master.CreatedAt) {
+			master.CreatedAt = identity.CreatedAt
+		}
+		if identity.ModifiedAt.After(master.ModifiedAt) {
+			master.ModifiedAt = identity.ModifiedAt
+		}
+	}
+	if master.UID == "" {
+		master = entities.LessonIdentity{
+			CreatedAt:  now,
+			ModifiedAt: now,
+		}
+	}
+	master.UID = seriesUID(master.ISU, ls.key)
+
+	event := s.addLessonEvent(cal, master, ls.template, loc, opts, templates)
+
+	until := ls.occurrences[len(ls.occurrences)-1].recurrenceID
+	event.AddRrule(fmt.Sprintf("FREQ=WEEKLY;INTERVAL=%d;UNTIL=%s", ls.interval, until.UTC().Format(_utcTimeFormat)))
+
+	for _, exdate := range ls.exdates {
+		event.AddExdate(exdate.In(loc).Format(_localTimeFormat), ics.WithTZID(loc.String()))
+	}
+
+	for _, occ := range ls.occurrences {
+		if !occ.override {
+			continue
+		}
+
+		identity, ok := opts.Identities[occ.item.slot]
+		if !ok {
+			identity = master
+		}
+		identity.UID = master.UID
+
+		override := s.addLessonEvent(cal, identity, occ.item.lesson, loc, opts, templates)
+		setLocalTime(&override.ComponentBase, ics.ComponentPropertyRecurrenceId, occ.recurrenceID, loc)
+	}
+}
+
+// _epoch is a Monday the parity of bi-weekly series is counted from.
+var _epoch = time.Date(1970, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+// seriesUID returns a UID that stays the same while the series moves through generated periods.
+func seriesUID(isu int64, key string) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%d|series|%s", isu, key)))
+
+	return hex.EncodeToString(h[:16]) + "@itmo-calendar"
+}
+
+// civilDate returns the local date of t as midnight UTC, so dates can be compared and counted.
+func civilDate(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// weeksBetween returns the number of whole weeks from one civil date to another.
+func weeksBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours()) / 24 / 7
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// expandRecurrence returns the starts of all occurrences of a weekly recurring event
+// except the excluded ones. Unsupported rules yield the first occurrence only.
+func expandRecurrence(event *ics.VEvent, start time.Time) []time.Time {
+	rrule := event.GetProperty(ics.ComponentPropertyRrule)
+	if rrule == nil {
+		return []time.Time{start}
+	}
+
+	var (
+		interval = 1
+		count    = _maxOccurrences
+		until    time.Time
+	)
+
+	for _, part := range strings.Split(rrule.Value, ";") {
+		name, value, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			if !strings.EqualFold(value, "WEEKLY") {
+				return []time.Time{start}
+			}
+		case "INTERVAL":
+			if n, err := strconv.Atoi(value); err == nil && n > 0 {
+				interval = n
+			}
+		case "COUNT":
+			if n, err := strconv.Atoi(value); err == nil && n > 0 && n < count {
+				count = n
+			}
+		case "UNTIL":
+			if t, ok := parseTime(&ics.IANAProperty{BaseProperty: ics.BaseProperty{Value: value}}); ok {
+				until = t
+			} else if t, err := time.ParseInLocation("20060102", value, start.Location()); err == nil {
+				until = t.AddDate(0, 0, 1).Add(-time.Second)
+			}
+		}
+	}
+
+	excluded := make(map[int64]struct{})
+	for _, exdate := range event.GetProperties(ics.ComponentPropertyExdate) {
+		for _, value := range strings.Split(exdate.Value, ",") {
+			prop := *exdate
+			prop.Value = value
+			if t, ok := parseTime(&prop); ok {
+				excluded[t.Unix()] = struct{}{}
+			}
+		}
+	}
+
+	var starts []time.Time
+	for i := 0; i < count; i++ {
+		// AddDate keeps the wall clock, so occurrences follow daylight saving changes.
+		occurrence := start.AddDate(0, 0, 7*interval*i)
+		if !until.IsZero() && occurrence.After(until) {
+			break
+		}
+		if _, ok := excluded[occurrence.Unix()]; ok {
+			continue
+		}
+		starts = append(starts, occurrence)
+	}
+
+	return starts
+}
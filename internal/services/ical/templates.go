@@ -0,0 +1,270 @@
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// _maxTemplateOutput limits the size of a rendered property.
+	_maxTemplateOutput = 4096
+	// _templateRenderTimeout limits rendering of the sample lesson when templates are validated.
+	_templateRenderTimeout = 100 * time.Millisecond
+)
+
+// ErrInvalidTemplate is returned when an event template does not parse or fails on a sample lesson.
+var ErrInvalidTemplate = errors.New("invalid event template")
+
+// _templateFuncs are the helper functions available to event templates.
+var _templateFuncs = template.FuncMap{
+	"short":    shortName,
+	"abbr":     abbreviate,
+	"truncate": truncate,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"trim":     strings.TrimSpace,
+	"default": func(fallback, value string) string {
+		if value == "" {
+			return fallback
+		}
+		return value
+	},
+}
+
+// _sampleLesson is the lesson templates are checked against before they are saved.
+var _sampleLesson = entities.Lesson{
+	Subject:     "Базы данных",
+	Type:        "Лекция",
+	TeacherName: "Иванов Иван Иванович",
+	Room:        "1404",
+	Note:        "Заметка",
+	Building:    "Кронверкский пр., д.49, лит.А",
+	Format:      "Очно",
+	Group:       "P3210",
+	ZoomURL:     "https://zoom.us/j/0",
+	Start:       time.Date(2024, time.September, 2, 8, 20, 0, 0, time.UTC),
+	End:         time.Date(2024, time.September, 2, 9, 50, 0, 0, time.UTC),
+}
+
+// eventTemplates are the parsed user templates, nil for the default rendering.
+type eventTemplates struct {
+	summary     *template.Template
+	description *template.Template
+	location    *template.Template
+}
+
+// ValidateTemplates checks that templates parse and render a sample lesson in time.
+func (s *Service) ValidateTemplates(templates entities.EventTemplates) error {
+	for _, t := range []struct{ name, text string }{
+		{"summary", templates.Summary},
+		{"description", templates.Description},
+		{"location", templates.Location},
+	} {
+		tpl, err := parseTemplate(t.name, t.text)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidTemplate, "%s: %v", t.name, err)
+		}
+		if tpl == nil {
+			continue
+		}
+
+		_, err = executeTemplateTimeout(tpl, _sampleLesson, _templateRenderTimeout)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidTemplate, "%s: %v", t.name, err)
+		}
+	}
+
+	return nil
+}
+
+// compileTemplates parses user templates, a template that does not parse falls back to the default.
+func compileTemplates(templates entities.EventTemplates) eventTemplates {
+	var compiled eventTemplates
+	compiled.summary, _ = parseTemplate("summary", templates.Summary)
+	compiled.description, _ = parseTemplate("description", templates.Description)
+	compiled.location, _ = parseTemplate("location", templates.Location)
+
+	return compiled
+}
+
+// parseTemplate parses a template, returning nil for an empty one.
+// Templates are rendered for every lesson of every user, so actions whose cost does not
+// follow from the size of the template, loops and template calls, are rejected.
+func parseTemplate(name, text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+
+	tpl, err := template.New(name).Option("missingkey=error").Funcs(_templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tpl.Templates()) > 1 {
+		return nil, errors.New("template definitions are not allowed")
+	}
+	if tpl.Tree != nil {
+		err = checkNode(tpl.Tree.Root)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tpl, nil
+}
+
+// checkNode rejects range and template actions anywhere under the node.
+func checkNode(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			err := checkNode(child)
+			if err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		return checkBranch(&n.BranchNode)
+	case *parse.WithNode:
+		return checkBranch(&n.BranchNode)
+	case *parse.RangeNode:
+		return errors.New("range is not allowed")
+	case *parse.TemplateNode:
+		return errors.New("template calls are not allowed")
+	}
+
+	return nil
+}
+
+func checkBranch(branch *parse.BranchNode) error {
+	err := checkNode(branch.List)
+	if err != nil {
+		return err
+	}
+
+	return checkNode(branch.ElseList)
+}
+
+// renderTemplate renders the lesson with the template, returning fallback when there is
+// no template or it fails or renders nothing.
+func renderTemplate(tpl *template.Template, lesson entities.Lesson, fallback string) string {
+	if tpl == nil {
+		return fallback
+	}
+
+	out, err := executeTemplate(tpl, lesson)
+	if err != nil || strings.TrimSpace(out) == "" {
+		return fallback
+	}
+
+	return out
+}
+
+func executeTemplate(tpl *template.Template, lesson entities.Lesson) (string, error) {
+	var buf limitedBuffer
+	err := tpl.Execute(&buf, lesson)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// executeTemplateTimeout renders the lesson, failing when it takes longer than timeout.
+// The rendering itself cannot be stopped and is left to finish in the background.
+func executeTemplateTimeout(tpl *template.Template, lesson entities.Lesson, timeout time.Duration) (string, error) {
+	type result struct {
+		out string
+		err error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		out, err := executeTemplate(tpl, lesson)
+		done <- result{out: out, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		return r.out, r.err
+	case <-timer.C:
+		return "", fmt.Errorf("rendering takes longer than %s", timeout)
+	}
+}
+
+// limitedBuffer fails writes beyond _maxTemplateOutput.
+type limitedBuffer struct {
+	bytes.Buffer
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > _maxTemplateOutput {
+		return 0, fmt.Errorf("output exceeds %d bytes", _maxTemplateOutput)
+	}
+
+	return b.Buffer.Write(p)
+}
+
+// shortName turns "Иванов Иван Иванович" into "Иванов И. И.".
+func shortName(name string) string {
+	parts := strings.Fields(name)
+	if len(parts) < 2 {
+		return strings.TrimSpace(name)
+	}
+
+	short := parts[0]
+	for _, part := range parts[1:] {
+		r, _ := utf8.DecodeRuneInString(part)
+		short += " " + string(r) + "."
+	}
+
+	return short
+}
+
+// abbreviate turns "Теория вероятностей и математическая статистика" into "ТВМС".
+// Words shorter than three letters are skipped, a single word is returned as is.
+func abbreviate(s string) string {
+	var abbr []rune
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if utf8.RuneCountInString(word) < 3 {
+			continue
+		}
+
+		r, _ := utf8.DecodeRuneInString(word)
+		abbr = append(abbr, unicode.ToUpper(r))
+	}
+
+	if len(abbr) < 2 {
+		return s
+	}
+
+	return string(abbr)
+}
+
+// truncate shortens s to at most n characters, marking the cut with an ellipsis.
+func truncate(n int, s string) string {
+	if n <= 0 || utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:n-1])) + "…"
+}
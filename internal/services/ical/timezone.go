@@ -0,0 +1,103 @@
+package ical
+
+import (
+	"fmt"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+const (
+	_localTimeFormat = "20060102T150405"
+	_utcTimeFormat   = "20060102T150405Z"
+)
+
+// addTimezone adds a VTIMEZONE for loc describing every offset in effect between from and to.
+// Observances are emitted as one-off components starting at each transition, so the block
+// stays valid for any zone Go knows about without having to derive recurrence rules.
+func addTimezone(cal *ics.Calendar, loc *time.Location, from, to time.Time) {
+	tz := cal.AddTimezone(loc.String())
+
+	// Start at the beginning of the year so the block only changes once a year.
+	start := time.Date(from.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+	_, prevOffset := start.Zone()
+
+	for _, transition := range append([]time.Time{start}, zoneTransitions(start, to.In(loc))...) {
+		name, offset := transition.Zone()
+
+		observance := ics.ComponentBase{}
+		observance.SetProperty(ics.ComponentPropertyDtStart,
+			transition.In(time.FixedZone("", prevOffset)).Format(_localTimeFormat))
+		observance.SetProperty(ics.ComponentProperty(ics.PropertyTzoffsetfrom), formatOffset(prevOffset))
+		observance.SetProperty(ics.ComponentProperty(ics.PropertyTzoffsetto), formatOffset(offset))
+		observance.SetProperty(ics.ComponentProperty(ics.PropertyTzname), name)
+
+		if transition.IsDST() {
+			tz.Components = append(tz.Components, &ics.Daylight{ComponentBase: observance})
+		} else {
+			tz.Components = append(tz.Components, &ics.Standard{ComponentBase: observance})
+		}
+
+		prevOffset = offset
+	}
+}
+
+// zoneTransitions returns the instants in (from, to] at which the UTC offset changes.
+func zoneTransitions(from, to time.Time) []time.Time {
+	var transitions []time.Time
+
+	_, offset := from.Zone()
+	for day := from; day.Before(to); {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.Zone(); nextOffset != offset {
+			// Narrow the change down to the minute.
+			lo, hi := day, next
+			for hi.Sub(lo) > time.Minute {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if _, midOffset := mid.Zone(); midOffset == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+
+			transitions = append(transitions, hi.Truncate(time.Minute))
+			offset = nextOffset
+		}
+		day = next
+	}
+
+	return transitions
+}
+
+// formatOffset formats a UTC offset in seconds as the iCalendar UTC-OFFSET value.
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+// setLocalTime sets a DATE-TIME property as local time with a TZID parameter.
+func setLocalTime(cb *ics.ComponentBase, property ics.ComponentProperty, t time.Time, loc *time.Location) {
+	cb.SetProperty(property, t.In(loc).Format(_localTimeFormat), ics.WithTZID(loc.String()))
+}
+
+// parseTime reads a DATE-TIME property in either UTC or TZID-qualified local form.
+func parseTime(prop *ics.IANAProperty) (time.Time, bool) {
+	if tzid, ok := prop.ICalParameters[string(ics.ParameterTzid)]; ok && len(tzid) == 1 {
+		loc, err := time.LoadLocation(tzid[0])
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		t, err := time.ParseInLocation(_localTimeFormat, prop.Value, loc)
+		return t, err == nil
+	}
+
+	t, err := time.Parse(_utcTimeFormat, prop.Value)
+	return t, err == nil
+}
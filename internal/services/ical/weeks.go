@@ -0,0 +1,51 @@
+package ical
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+// _propWeek marks academic week events and holds the week number.
+const _propWeek ics.ComponentProperty = "X-ITMO-WEEK"
+
+// addWeekMarkers adds an all-day event on the Monday of every academic week between from and to.
+func (s *Service) addWeekMarkers(cal *ics.Calendar, from, to time.Time, loc *time.Location, locale i18n.Locale) {
+	last := civilDate(to, loc)
+	first := civilDate(from, loc)
+	// Step from the Monday of the first week, so the last week is reached whatever its weekday.
+	first = first.AddDate(0, 0, -(int(first.Weekday())+6)%7)
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 7) {
+		week := s.academic.WeekOf(day)
+		if week == nil {
+			continue
+		}
+
+		event := cal.AddEvent(fmt.Sprintf("week-%s@itmo-calendar", week.Monday.Format("20060102")))
+		event.SetSummary(weekTitle(*week, locale))
+		event.SetDtStampTime(week.Monday)
+		event.SetAllDayStartAt(week.Monday)
+		event.SetAllDayEndAt(week.Monday.AddDate(0, 0, 1))
+		event.SetTimeTransparency(ics.TransparencyTransparent)
+		event.SetProperty(_propWeek, strconv.Itoa(week.Number))
+	}
+}
+
+// weekTitle returns the summary of a week marker, e.g. "Week 7 (odd)".
+func weekTitle(week entities.AcademicWeek, locale i18n.Locale) string {
+	switch {
+	case week.Period == entities.WeekPeriodSession:
+		return i18n.T(locale, i18n.WeekSession)
+	case week.Period == entities.WeekPeriodHoliday:
+		return i18n.T(locale, i18n.WeekHoliday)
+	case week.Odd:
+		return i18n.T(locale, i18n.WeekOdd, week.Number)
+	default:
+		return i18n.T(locale, i18n.WeekEven, week.Number)
+	}
+}
@@ -0,0 +1,12 @@
+package identities
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Repo interface {
+	GetByISU(ctx context.Context, isu int64) ([]entities.LessonIdentity, error)
+	Upsert(ctx context.Context, identities []entities.LessonIdentity) error
+}
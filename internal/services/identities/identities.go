@@ -0,0 +1,107 @@
+package identities
+
+import (
+	"context"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
+	"github.com/pkg/errors"
+)
+
+// Service keeps lesson identities stable across calendar regenerations.
+type Service struct {
+	repo Repo
+}
+
+func New(repo Repo) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// Assign returns identities for every lesson of the schedule keyed by slot.
+// New slots get a fresh identity, slots whose lesson changed get their
+// SEQUENCE and modification time bumped, unchanged slots are returned as is.
+// A cancelled slot that is back in the schedule is restored with a bumped SEQUENCE.
+func (s *Service) Assign(ctx context.Context, isu int64, schedule []entities.DaySchedule) (map[string]entities.LessonIdentity, error) {
+	stored, err := s.repo.GetByISU(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get lesson identities")
+	}
+
+	identities := make(map[string]entities.LessonIdentity, len(stored))
+	for _, identity := range stored {
+		identities[identity.Slot] = identity
+	}
+
+	now := time.Now().UTC()
+	var changed []entities.LessonIdentity
+
+	for _, day := range schedule {
+		slots := day.Slots()
+		for i, lesson := range day.Lessons {
+			slot := slots[i]
+			fingerprint := lesson.Fingerprint()
+
+			identity, ok := identities[slot]
+			switch {
+			case !ok:
+				identity = entities.NewLessonIdentity(isu, slot, fingerprint, now)
+			case identity.Fingerprint != fingerprint || identity.CancelledAt != nil:
+				identity.Sequence++
+				identity.Fingerprint = fingerprint
+				identity.ModifiedAt = now
+				identity.CancelledAt = nil
+			default:
+				continue
+			}
+
+			identities[slot] = identity
+			changed = append(changed, identity)
+		}
+	}
+
+	err = s.repo.Upsert(ctx, changed)
+	if err != nil {
+		return nil, errors.Wrap(err, "upsert lesson identities")
+	}
+
+	return identities, nil
+}
+
+// Cancel marks identities with the given UIDs as cancelled and bumps their SEQUENCE.
+// The identities map is updated in place.
+func (s *Service) Cancel(ctx context.Context, identities map[string]entities.LessonIdentity, uids []string) error {
+	if len(uids) == 0 {
+		return nil
+	}
+
+	cancelled := make(map[string]struct{}, len(uids))
+	for _, uid := range uids {
+		cancelled[uid] = struct{}{}
+	}
+
+	now := time.Now().UTC()
+	var changed []entities.LessonIdentity
+
+	for slot, identity := range identities {
+		if _, ok := cancelled[identity.UID]; !ok || identity.CancelledAt != nil {
+			continue
+		}
+
+		identity.Sequence++
+		identity.ModifiedAt = now
+		identity.CancelledAt = &now
+
+		identities[slot] = identity
+		changed = append(changed, identity)
+	}
+
+	err := s.repo.Upsert(ctx, changed)
+	if err != nil {
+		return errors.Wrap(err, "upsert lesson identities")
+	}
+
+	return nil
+}
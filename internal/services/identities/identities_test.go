@@ -0,0 +1,91 @@
+package identities
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type memoryRepo struct {
+	identities map[string]entities.LessonIdentity
+	upserts    int
+}
+
+func (r *memoryRepo) GetByISU(_ context.Context, _ int64) ([]entities.LessonIdentity, error) {
+	result := make([]entities.LessonIdentity, 0, len(r.identities))
+	for _, identity := range r.identities {
+		result = append(result, identity)
+	}
+	return result, nil
+}
+
+func (r *memoryRepo) Upsert(_ context.Context, identities []entities.LessonIdentity) error {
+	for _, identity := range identities {
+		r.identities[identity.Slot] = identity
+		r.upserts++
+	}
+	return nil
+}
+
+func TestAssign(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 9, 2, 8, 20, 0, 0, time.UTC)
+	day := entities.DaySchedule{
+		Date: time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC),
+		Lessons: []entities.Lesson{
+			{Subject: "Databases", Type: "Lecture", TeacherName: "Ivanov", Start: start, End: start.Add(90 * time.Minute)},
+			{Subject: "Databases", Type: "Lecture", TeacherName: "Ivanov", Start: start.Add(100 * time.Minute), End: start.Add(190 * time.Minute)},
+		},
+	}
+
+	repo := &memoryRepo{identities: map[string]entities.LessonIdentity{}}
+	s := New(repo)
+
+	first, err := s.Assign(ctx, 1, []entities.DaySchedule{day})
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	assert.Equal(t, 2, repo.upserts)
+
+	slots := day.Slots()
+	assert.NotEqual(t, first[slots[0]].UID, first[slots[1]].UID)
+
+	t.Run("unchanged schedule keeps identities", func(t *testing.T) {
+		again, err := s.Assign(ctx, 1, []entities.DaySchedule{day})
+		require.NoError(t, err)
+		assert.Equal(t, first, again)
+		assert.Equal(t, 2, repo.upserts)
+	})
+
+	t.Run("changed teacher bumps sequence", func(t *testing.T) {
+		changed := day
+		changed.Lessons = append([]entities.Lesson(nil), day.Lessons...)
+		changed.Lessons[0].TeacherName = "Petrov"
+
+		updated, err := s.Assign(ctx, 1, []entities.DaySchedule{changed})
+		require.NoError(t, err)
+
+		assert.Equal(t, first[slots[0]].UID, updated[slots[0]].UID)
+		assert.Equal(t, first[slots[0]].CreatedAt, updated[slots[0]].CreatedAt)
+		assert.Equal(t, 1, updated[slots[0]].Sequence)
+		assert.Equal(t, 0, updated[slots[1]].Sequence)
+	})
+	t.Run("cancelled slot is restored with a new sequence", func(t *testing.T) {
+		identities, err := s.Assign(ctx, 1, nil)
+		require.NoError(t, err)
+
+		err = s.Cancel(ctx, identities, []string{first[slots[1]].UID})
+		require.NoError(t, err)
+		require.NotNil(t, identities[slots[1]].CancelledAt)
+		assert.Equal(t, 1, identities[slots[1]].Sequence)
+
+		restored, err := s.Assign(ctx, 1, []entities.DaySchedule{day})
+		require.NoError(t, err)
+		assert.Nil(t, restored[slots[1]].CancelledAt)
+		assert.Equal(t, 2, restored[slots[1]].Sequence)
+	})
+}
@@ -0,0 +1,12 @@
+package keys
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Repo interface {
+	KeyUsage(ctx context.Context) (map[string]int64, error)
+	Reencrypt(ctx context.Context, after int64, limit int) (entities.ReencryptPage, error)
+}
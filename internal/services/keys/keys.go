@@ -0,0 +1,69 @@
+package keys
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// Service tracks which keys stored secrets are encrypted with and moves them to the current one.
+// Secrets live in several stores, e.g. OAuth tokens and CalDAV passwords, all sharing one keyring.
+type Service struct {
+	stores  map[string]Repo
+	current string
+}
+
+func New(stores map[string]Repo, current string) *Service {
+	return &Service{
+		stores:  stores,
+		current: current,
+	}
+}
+
+// Usage returns row counts by key across all stores.
+func (s *Service) Usage(ctx context.Context) (*entities.KeyUsage, error) {
+	usage := &entities.KeyUsage{
+		Current: s.current,
+		Rows:    map[string]int64{},
+	}
+	for _, name := range s.Stores() {
+		rows, err := s.stores[name].KeyUsage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get key usage of %s", name)
+		}
+		for id, count := range rows {
+			usage.Rows[id] += count
+		}
+	}
+
+	return usage, nil
+}
+
+// Stores returns the names of the stores in a stable order.
+func (s *Service) Stores() []string {
+	names := make([]string, 0, len(s.stores))
+	for name := range s.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Reencrypt moves up to limit rows of the store after the ISU to the current key.
+func (s *Service) Reencrypt(ctx context.Context, store string, after int64, limit int) (entities.ReencryptPage, error) {
+	repo, ok := s.stores[store]
+	if !ok {
+		return entities.ReencryptPage{}, errors.Errorf("unknown store %q", store)
+	}
+
+	page, err := repo.Reencrypt(ctx, after, limit)
+	if err != nil {
+		return page, errors.Wrapf(err, "reencrypt %s", store)
+	}
+
+	return page, nil
+}
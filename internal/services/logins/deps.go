@@ -0,0 +1,25 @@
+package logins
+
+import (
+	"context"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Repo interface {
+	Create(ctx context.Context, login entities.PendingLogin) error
+	Take(ctx context.Context, state string) (*entities.PendingLogin, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type Tokens interface {
+	StartLogin(state string) (string, string, error)
+	Exchange(ctx context.Context, code, codeVerifier string) (*entities.UserTokens, error)
+	Get(ctx context.Context, isu int64, password string) (*entities.PasswordLogin, error)
+	Submit(ctx context.Context, login entities.PendingLogin, answer entities.ChallengeAnswer) (*entities.PasswordLogin, error)
+}
+
+type UserTokensRepo interface {
+	UpsertUserTokens(ctx context.Context, tokens *entities.UserTokens) error
+}
@@ -0,0 +1,199 @@
+package logins
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+const _stateBytes = 32
+
+var (
+	// ErrInvalidLogin is returned when the state of a callback or challenge is unknown, already used or expired.
+	ErrInvalidLogin = errors.New("invalid login state")
+	// ErrAnswerMissing is returned when an answer lacks the input its challenge asks for.
+	ErrAnswerMissing = errors.New("challenge answer missing")
+)
+
+// ChallengeError is returned when a password login stops at a page the user has to fill in.
+// The login resumes with Answer and the ID of the challenge.
+type ChallengeError struct {
+	Challenge entities.LoginChallenge
+}
+
+func (e *ChallengeError) Error() string {
+	return "login challenge: " + string(e.Challenge.Kind)
+}
+
+// Service runs logins at ITMO ID. Browser logins use PKCE and the user's password never reaches us,
+// deprecated password logins are kept across the pages Keycloak asks the user to fill in.
+type Service struct {
+	repo       Repo
+	tokens     Tokens
+	userTokens UserTokensRepo
+	ttl        time.Duration
+	now        func() time.Time
+}
+
+func New(repo Repo, tokens Tokens, userTokens UserTokensRepo, ttl time.Duration) *Service {
+	return &Service{
+		repo:       repo,
+		tokens:     tokens,
+		userTokens: userTokens,
+		ttl:        ttl,
+		now:        time.Now,
+	}
+}
+
+// Start starts a login, the browser has to be sent to the returned URL.
+func (s *Service) Start(ctx context.Context) (*entities.LoginRequest, error) {
+	now := s.now()
+
+	_, err := s.repo.DeleteExpired(ctx, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "delete expired logins")
+	}
+
+	state, err := generateState()
+	if err != nil {
+		return nil, errors.Wrap(err, "generate state")
+	}
+
+	loginURL, codeVerifier, err := s.tokens.StartLogin(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "start login")
+	}
+
+	err = s.repo.Create(ctx, entities.PendingLogin{
+		State:        state,
+		CodeVerifier: codeVerifier,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(s.ttl),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "store login")
+	}
+
+	return &entities.LoginRequest{
+		State: state,
+		URL:   loginURL,
+	}, nil
+}
+
+// Complete exchanges the code of the login callback for ITMO ID tokens and stores them.
+func (s *Service) Complete(ctx context.Context, state, code string) (*entities.UserTokens, error) {
+	login, err := s.repo.Take(ctx, state)
+	if err != nil {
+		return nil, errors.Wrap(err, "take login")
+	}
+	if login == nil || login.Form != nil || !s.now().Before(login.ExpiresAt) {
+		return nil, ErrInvalidLogin
+	}
+
+	tokens, err := s.tokens.Exchange(ctx, code, login.CodeVerifier)
+	if err != nil {
+		return nil, errors.Wrap(err, "exchange code")
+	}
+
+	err = s.userTokens.UpsertUserTokens(ctx, tokens)
+	if err != nil {
+		return nil, errors.Wrap(err, "upsert tokens")
+	}
+
+	return tokens, nil
+}
+
+// PasswordLogin logs the user in with their ISU password and stores the tokens.
+// Returns a ChallengeError if ITMO ID asks for more, e.g. a one-time code.
+func (s *Service) PasswordLogin(ctx context.Context, isu int64, password string) (*entities.UserTokens, error) {
+	login, err := s.tokens.Get(ctx, isu, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "get tokens")
+	}
+
+	return s.proceed(ctx, login)
+}
+
+// Answer resumes a password login stopped by the challenge.
+func (s *Service) Answer(ctx context.Context, challengeID string, answer entities.ChallengeAnswer) (*entities.UserTokens, error) {
+	pending, err := s.repo.Take(ctx, challengeID)
+	if err != nil {
+		return nil, errors.Wrap(err, "take login")
+	}
+	if pending == nil || pending.Form == nil || !s.now().Before(pending.ExpiresAt) {
+		return nil, ErrInvalidLogin
+	}
+
+	if !answer.Answers(pending.Form.Kind) {
+		// Keep the login, the client can answer again.
+		err = s.repo.Create(ctx, *pending)
+		if err != nil {
+			return nil, errors.Wrap(err, "store login")
+		}
+
+		return nil, ErrAnswerMissing
+	}
+
+	login, err := s.tokens.Submit(ctx, *pending, answer)
+	if err != nil {
+		return nil, errors.Wrap(err, "submit answer")
+	}
+
+	return s.proceed(ctx, login)
+}
+
+// proceed stores the tokens of a completed login, or the form of a challenged one.
+func (s *Service) proceed(ctx context.Context, login *entities.PasswordLogin) (*entities.UserTokens, error) {
+	if login.Tokens != nil {
+		err := s.userTokens.UpsertUserTokens(ctx, login.Tokens)
+		if err != nil {
+			return nil, errors.Wrap(err, "upsert tokens")
+		}
+
+		return login.Tokens, nil
+	}
+
+	state, err := generateState()
+	if err != nil {
+		return nil, errors.Wrap(err, "generate state")
+	}
+
+	now := s.now()
+	pending := entities.PendingLogin{
+		State:        state,
+		CodeVerifier: login.CodeVerifier,
+		ISU:          login.ISU,
+		Form:         login.Form,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(s.ttl),
+	}
+
+	err = s.repo.Create(ctx, pending)
+	if err != nil {
+		return nil, errors.Wrap(err, "store login")
+	}
+
+	return nil, &ChallengeError{
+		Challenge: entities.LoginChallenge{
+			ID:        state,
+			Kind:      login.Form.Kind,
+			Message:   login.Form.Message,
+			ExpiresAt: pending.ExpiresAt,
+		},
+	}
+}
+
+func generateState() (string, error) {
+	b := make([]byte, _stateBytes)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
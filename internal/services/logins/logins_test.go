@@ -0,0 +1,286 @@
+package logins
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	itmotokens "github.com/hexarchy/itmo-calendar/internal/adapters/itmo-tokens"
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+const (
+	_clientID    = "calendar"
+	_callbackURI = "https://calendar.test/api/v1/auth/callback"
+	_redirectURI = "https://my.itmo.ru/login/callback"
+	_password    = "secret"
+	_otp         = "123456"
+)
+
+// provider is a fake OIDC provider issuing codes for the authorization code flow with PKCE.
+// Password logins go through Keycloak like pages: the login form, a one-time code and the terms.
+type provider struct {
+	t          *testing.T
+	isu        int64
+	mu         sync.Mutex
+	challenges map[string]string
+}
+
+func newProvider(t *testing.T, isu int64) *httptest.Server {
+	p := &provider{t: t, isu: isu, challenges: map[string]string{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/protocol/openid-connect/auth", p.authorize)
+	mux.HandleFunc("/protocol/openid-connect/token", p.token)
+	mux.HandleFunc("/login-actions/authenticate", p.authenticate)
+	mux.HandleFunc("/login-actions/required-action", p.requiredAction)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func (p *provider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	assert.Equal(p.t, _clientID, q.Get("client_id"))
+	assert.Equal(p.t, "S256", q.Get("code_challenge_method"))
+
+	code := "code-" + q.Get("state")
+	p.mu.Lock()
+	p.challenges[code] = q.Get("code_challenge")
+	p.mu.Unlock()
+
+	if q.Get("redirect_uri") == _redirectURI {
+		http.SetCookie(w, &http.Cookie{Name: "AUTH_SESSION_ID", Value: code})
+		_, _ = w.Write([]byte(`<form id="kc-form-login" class="form" action="http://` + r.Host + `/login-actions/authenticate?session_code=1&amp;tab_id=t" method="post">` +
+			`<input type="hidden" name="credentialId" value="">` +
+			`<input name="username"><input name="password" type="password"></form>`))
+		return
+	}
+
+	assert.Equal(p.t, _callbackURI, q.Get("redirect_uri"))
+	http.Redirect(w, r, q.Get("redirect_uri")+"?"+url.Values{"code": {code}, "state": {q.Get("state")}}.Encode(), http.StatusFound)
+}
+
+func (p *provider) authenticate(w http.ResponseWriter, r *http.Request) {
+	require.NoError(p.t, r.ParseForm())
+	assert.Equal(p.t, "t", r.URL.Query().Get("tab_id"))
+	_, err := r.Cookie("AUTH_SESSION_ID")
+	require.NoError(p.t, err, "the Keycloak session is kept")
+
+	otpPage := func(feedback string) {
+		_, _ = w.Write([]byte(`<form id="kc-otp-login-form" action="http://` + r.Host + `/login-actions/authenticate?session_code=2&amp;tab_id=t" method="post">` +
+			`<input name="otp">` + feedback + `</form>`))
+	}
+
+	switch {
+	case r.PostForm.Has("password") && r.PostForm.Get("password") != _password:
+		_, _ = w.Write([]byte(`<form id="kc-form-login" action="/login-actions/authenticate?session_code=3&amp;tab_id=t" method="post">` +
+			`<span class="invalid-feedback">Invalid username or password.</span></form>`))
+	case r.PostForm.Has("password"):
+		assert.Equal(p.t, "123456", r.PostForm.Get("username"))
+		otpPage("")
+	case r.PostForm.Get("otp") != _otp:
+		otpPage(`<span id="input-error-otp-code" class="pf-m-error kc-feedback-text">Invalid authenticator code.</span>`)
+	default:
+		http.Redirect(w, r, "/login-actions/required-action?execution=TERMS", http.StatusFound)
+	}
+}
+
+func (p *provider) requiredAction(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie("AUTH_SESSION_ID")
+	require.NoError(p.t, err, "the Keycloak session is kept")
+
+	if r.Method == http.MethodGet {
+		_, _ = w.Write([]byte(`<div id="kc-terms-text">Terms</div><form class="form-actions" action="/login-actions/required-action?execution=TERMS" method="POST">` +
+			`<input name="accept" id="kc-accept" type="submit" value="Accept"><input name="cancel" id="kc-decline" type="submit" value="Decline"></form>`))
+		return
+	}
+
+	require.NoError(p.t, r.ParseForm())
+	assert.True(p.t, r.PostForm.Has("accept"))
+	http.Redirect(w, r, _redirectURI+"?"+url.Values{"code": {cookie.Value}}.Encode(), http.StatusFound)
+}
+
+func (p *provider) token(w http.ResponseWriter, r *http.Request) {
+	require.NoError(p.t, r.ParseForm())
+	assert.Equal(p.t, "authorization_code", r.PostForm.Get("grant_type"))
+	assert.Contains(p.t, []string{_callbackURI, _redirectURI}, r.PostForm.Get("redirect_uri"))
+
+	p.mu.Lock()
+	challenge, ok := p.challenges[r.PostForm.Get("code")]
+	delete(p.challenges, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := json.Marshal(map[string]any{"isu": p.isu})
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token":       "e30." + base64.RawURLEncoding.EncodeToString(claims) + ".sig",
+		"refresh_token":      "refresh",
+		"expires_in":         300,
+		"refresh_expires_in": 1800,
+	})
+}
+
+type memoryRepo struct {
+	logins map[string]entities.PendingLogin
+}
+
+func (r *memoryRepo) Create(_ context.Context, login entities.PendingLogin) error {
+	r.logins[login.State] = login
+	return nil
+}
+
+func (r *memoryRepo) Take(_ context.Context, state string) (*entities.PendingLogin, error) {
+	login, ok := r.logins[state]
+	if !ok {
+		return nil, nil
+	}
+	delete(r.logins, state)
+	return &login, nil
+}
+
+func (r *memoryRepo) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	var deleted int64
+	for state, login := range r.logins {
+		if login.ExpiresAt.Before(now) {
+			delete(r.logins, state)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+type memoryTokens struct {
+	tokens map[int64]*entities.UserTokens
+}
+
+func (r *memoryTokens) UpsertUserTokens(_ context.Context, tokens *entities.UserTokens) error {
+	r.tokens[tokens.ISU] = tokens
+	return nil
+}
+
+// follow opens the login URL like a browser and returns the query ITMO ID redirects back with.
+func follow(t *testing.T, loginURL string) url.Values {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(loginURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+
+	return location.Query()
+}
+
+func TestLogins(t *testing.T) {
+	ctx := context.Background()
+	srv := newProvider(t, 123456)
+	client := itmotokens.New(_clientID, _redirectURI, _callbackURI, srv.URL, zap.NewNop())
+	userTokens := &memoryTokens{tokens: map[int64]*entities.UserTokens{}}
+	s := New(&memoryRepo{logins: map[string]entities.PendingLogin{}}, client, userTokens, 10*time.Minute)
+
+	login, err := s.Start(ctx)
+	require.NoError(t, err)
+	callback := follow(t, login.URL)
+	assert.Equal(t, login.State, callback.Get("state"))
+
+	tokens, err := s.Complete(ctx, callback.Get("state"), callback.Get("code"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456), tokens.ISU)
+	assert.Equal(t, "refresh", userTokens.tokens[123456].RefreshToken)
+
+	// A callback can not be replayed.
+	_, err = s.Complete(ctx, callback.Get("state"), callback.Get("code"))
+	assert.ErrorIs(t, err, ErrInvalidLogin)
+
+	_, err = s.Complete(ctx, "unknown", callback.Get("code"))
+	assert.ErrorIs(t, err, ErrInvalidLogin)
+
+	// Expired.
+	login, err = s.Start(ctx)
+	require.NoError(t, err)
+	callback = follow(t, login.URL)
+	s.now = func() time.Time { return time.Now().Add(time.Hour) }
+	_, err = s.Complete(ctx, callback.Get("state"), callback.Get("code"))
+	assert.ErrorIs(t, err, ErrInvalidLogin)
+}
+
+func TestLoginsWrongVerifier(t *testing.T) {
+	ctx := context.Background()
+	srv := newProvider(t, 123456)
+	client := itmotokens.New(_clientID, _redirectURI, _callbackURI, srv.URL, zap.NewNop())
+	repo := &memoryRepo{logins: map[string]entities.PendingLogin{}}
+	s := New(repo, client, &memoryTokens{tokens: map[int64]*entities.UserTokens{}}, 10*time.Minute)
+
+	login, err := s.Start(ctx)
+	require.NoError(t, err)
+	callback := follow(t, login.URL)
+
+	// A code intercepted on the redirect is useless without the verifier kept on the server.
+	pending := repo.logins[login.State]
+	pending.CodeVerifier = "stolen-code-has-no-verifier"
+	repo.logins[login.State] = pending
+
+	_, err = s.Complete(ctx, callback.Get("state"), callback.Get("code"))
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidLogin)
+}
+
+func TestPasswordLogin(t *testing.T) {
+	ctx := context.Background()
+	srv := newProvider(t, 123456)
+	client := itmotokens.New(_clientID, _redirectURI, _callbackURI, srv.URL, zap.NewNop())
+	userTokens := &memoryTokens{tokens: map[int64]*entities.UserTokens{}}
+	repo := &memoryRepo{logins: map[string]entities.PendingLogin{}}
+	s := New(repo, client, userTokens, 10*time.Minute)
+
+	_, err := s.PasswordLogin(ctx, 123456, "wrong")
+	assert.ErrorIs(t, err, itmotokens.ErrInvalidCredentials)
+
+	_, err = s.PasswordLogin(ctx, 123456, _password)
+	var challenge *ChallengeError
+	require.ErrorAs(t, err, &challenge)
+	assert.Equal(t, entities.ChallengeOTP, challenge.Challenge.Kind)
+
+	// The answer has to fit the challenge, the login is kept until it does.
+	_, err = s.Answer(ctx, challenge.Challenge.ID, entities.ChallengeAnswer{AcceptTerms: true})
+	assert.ErrorIs(t, err, ErrAnswerMissing)
+
+	_, err = s.Answer(ctx, challenge.Challenge.ID, entities.ChallengeAnswer{OTP: "000000"})
+	require.ErrorAs(t, err, &challenge)
+	assert.Equal(t, entities.ChallengeOTP, challenge.Challenge.Kind)
+	assert.Equal(t, "Invalid authenticator code.", challenge.Challenge.Message)
+
+	previous := challenge.Challenge.ID
+	_, err = s.Answer(ctx, challenge.Challenge.ID, entities.ChallengeAnswer{OTP: _otp})
+	require.ErrorAs(t, err, &challenge)
+	assert.Equal(t, entities.ChallengeTerms, challenge.Challenge.Kind)
+
+	_, err = s.Answer(ctx, previous, entities.ChallengeAnswer{OTP: _otp})
+	assert.ErrorIs(t, err, ErrInvalidLogin, "answered challenges can not be replayed")
+
+	tokens, err := s.Answer(ctx, challenge.Challenge.ID, entities.ChallengeAnswer{AcceptTerms: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456), tokens.ISU)
+	assert.Equal(t, "refresh", userTokens.tokens[123456].RefreshToken)
+	assert.Empty(t, repo.logins)
+}
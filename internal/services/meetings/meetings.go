@@ -0,0 +1,128 @@
+package meetings
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+const (
+	_maxDays  = 31
+	_maxLimit = 50
+)
+
+// ErrInvalidQuery is returned when a meeting query fails validation.
+var ErrInvalidQuery = errors.New("invalid meeting query")
+
+// Service finds time slots where several students are free at once.
+type Service struct {
+	timeZone string
+}
+
+// New returns a new meetings service reading working hours in the given default time zone.
+func New(timeZone string) *Service {
+	return &Service{
+		timeZone: timeZone,
+	}
+}
+
+// Slots returns the free slots common to all schedules within the working hours of every day
+// of the query. Longer slots are ranked first, equal ones by start.
+func (s *Service) Slots(schedules [][]entities.DaySchedule, query entities.MeetingQuery) ([]entities.MeetingSlot, error) {
+	timeZone := query.TimeZone
+	if timeZone == "" {
+		timeZone = s.timeZone
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidQuery, "unknown time zone %q", timeZone)
+	}
+
+	first := time.Date(query.From.Year(), query.From.Month(), query.From.Day(), 0, 0, 0, 0, loc)
+	last := time.Date(query.To.Year(), query.To.Month(), query.To.Day(), 0, 0, 0, 0, loc)
+	switch {
+	case last.Before(first):
+		return nil, errors.Wrap(ErrInvalidQuery, "range ends before it starts")
+	case last.Sub(first) >= _maxDays*24*time.Hour:
+		return nil, errors.Wrapf(ErrInvalidQuery, "range is longer than %d days", _maxDays)
+	case query.DayStart < 0 || query.DayEnd > 24*time.Hour || query.DayEnd <= query.DayStart:
+		return nil, errors.Wrap(ErrInvalidQuery, "working hours end before they start")
+	case query.MinDuration <= 0:
+		return nil, errors.Wrap(ErrInvalidQuery, "minimum duration must be positive")
+	}
+
+	from := first.Add(query.DayStart)
+	to := last.Add(query.DayEnd)
+
+	var busy []entities.BusyPeriod
+	for _, schedule := range schedules {
+		busy = append(busy, entities.NewFreeBusy(0, withTravel(schedule, query.Travel), from, to, 0, 0).Busy...)
+	}
+	// Merging everybody's periods leaves the time at least one participant is busy.
+	busy = entities.MergeBusy(busy)
+
+	var slots []entities.MeetingSlot
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		start := day.Add(query.DayStart)
+		end := day.Add(query.DayEnd)
+
+		for _, period := range busy {
+			if !period.End.After(start) {
+				continue
+			}
+			if !period.Start.Before(end) {
+				break
+			}
+			slots = appendSlot(slots, start, period.Start, query.MinDuration)
+			start = period.End
+		}
+		slots = appendSlot(slots, start, end, query.MinDuration)
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		return slots[i].Duration() > slots[j].Duration()
+	})
+
+	limit := query.Limit
+	if limit <= 0 || limit > _maxLimit {
+		limit = _maxLimit
+	}
+	if len(slots) > limit {
+		slots = slots[:limit]
+	}
+
+	return slots, nil
+}
+
+// withTravel widens lessons held in a building by the time needed to get there and back.
+func withTravel(schedule []entities.DaySchedule, travel time.Duration) []entities.DaySchedule {
+	if travel <= 0 {
+		return schedule
+	}
+
+	widened := make([]entities.DaySchedule, 0, len(schedule))
+	for _, day := range schedule {
+		lessons := make([]entities.Lesson, 0, len(day.Lessons))
+		for _, lesson := range day.Lessons {
+			if !lesson.Remote() {
+				lesson.Start = lesson.Start.Add(-travel)
+				lesson.End = lesson.End.Add(travel)
+			}
+			lessons = append(lessons, lesson)
+		}
+		widened = append(widened, entities.DaySchedule{Date: day.Date, Lessons: lessons})
+	}
+
+	return widened
+}
+
+func appendSlot(slots []entities.MeetingSlot, start, end time.Time, minDuration time.Duration) []entities.MeetingSlot {
+	if end.Sub(start) < minDuration {
+		return slots
+	}
+
+	return append(slots, entities.MeetingSlot{Start: start.UTC(), End: end.UTC()})
+}
@@ -0,0 +1,82 @@
+package meetings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+func TestSlots(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 10, day, hour, minute, 0, 0, loc)
+	}
+	lesson := func(start time.Time, building string) entities.Lesson {
+		return entities.Lesson{Start: start, End: start.Add(90 * time.Minute), Building: building}
+	}
+
+	alice := []entities.DaySchedule{{Lessons: []entities.Lesson{
+		lesson(at(16, 10, 0), "Kronverksky pr., 49"),
+		lesson(at(16, 15, 0), ""),
+	}}}
+	bob := []entities.DaySchedule{{Lessons: []entities.Lesson{
+		lesson(at(16, 12, 0), "Lomonosova, 9"),
+	}}}
+
+	query := entities.MeetingQuery{
+		From:        at(16, 0, 0),
+		To:          at(17, 0, 0),
+		MinDuration: time.Hour,
+		DayStart:    9 * time.Hour,
+		DayEnd:      18 * time.Hour,
+	}
+
+	s := New("Europe/Moscow")
+
+	t.Run("common free time ranked by length", func(t *testing.T) {
+		slots, err := s.Slots([][]entities.DaySchedule{alice, bob}, query)
+		require.NoError(t, err)
+		assert.Equal(t, []entities.MeetingSlot{
+			{Start: at(17, 9, 0).UTC(), End: at(17, 18, 0).UTC()},
+			{Start: at(16, 13, 30).UTC(), End: at(16, 15, 0).UTC()},
+			{Start: at(16, 16, 30).UTC(), End: at(16, 18, 0).UTC()},
+			{Start: at(16, 9, 0).UTC(), End: at(16, 10, 0).UTC()},
+		}, slots)
+	})
+
+	t.Run("travel between buildings", func(t *testing.T) {
+		query := query
+		query.MinDuration = 30 * time.Minute
+		query.Travel = 30 * time.Minute
+
+		slots, err := s.Slots([][]entities.DaySchedule{alice, bob}, query)
+		require.NoError(t, err)
+
+		var day []entities.MeetingSlot
+		for _, slot := range slots {
+			if slot.Start.Before(at(17, 0, 0)) {
+				day = append(day, slot)
+			}
+		}
+		// Lessons in buildings are widened by half an hour, the online one at 15:00 is not.
+		assert.Equal(t, []entities.MeetingSlot{
+			{Start: at(16, 16, 30).UTC(), End: at(16, 18, 0).UTC()},
+			{Start: at(16, 14, 0).UTC(), End: at(16, 15, 0).UTC()},
+			{Start: at(16, 9, 0).UTC(), End: at(16, 9, 30).UTC()},
+		}, day)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		query := query
+		query.DayEnd = 8 * time.Hour
+
+		_, err := s.Slots(nil, query)
+		require.ErrorIs(t, err, ErrInvalidQuery)
+	})
+}
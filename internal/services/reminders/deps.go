@@ -0,0 +1,12 @@
+package reminders
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Repo interface {
+	Get(ctx context.Context, isu int64) ([]entities.ReminderRule, error)
+	Replace(ctx context.Context, isu int64, rules []entities.ReminderRule) error
+}
@@ -0,0 +1,54 @@
+package reminders
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+const (
+	_maxRules         = 20
+	_maxMinutesBefore = 7 * 24 * 60
+)
+
+// ErrInvalidRules is returned when reminder rules fail validation.
+var ErrInvalidRules = errors.New("invalid reminder rules")
+
+type Service struct {
+	repo Repo
+}
+
+func New(repo Repo) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+func (s *Service) Get(ctx context.Context, isu int64) ([]entities.ReminderRule, error) {
+	rules, err := s.repo.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get reminders")
+	}
+	return rules, nil
+}
+
+// Replace validates and stores the user's reminder rules.
+func (s *Service) Replace(ctx context.Context, isu int64, rules []entities.ReminderRule) error {
+	if len(rules) > _maxRules {
+		return errors.Wrapf(ErrInvalidRules, "at most %d rules allowed", _maxRules)
+	}
+
+	for i, rule := range rules {
+		if rule.MinutesBefore != nil && (*rule.MinutesBefore < 0 || *rule.MinutesBefore > _maxMinutesBefore) {
+			return errors.Wrapf(ErrInvalidRules, "rule %d: minutes_before must be between 0 and %d", i, _maxMinutesBefore)
+		}
+	}
+
+	err := s.repo.Replace(ctx, isu, rules)
+	if err != nil {
+		return errors.Wrap(err, "replace reminders")
+	}
+	return nil
+}
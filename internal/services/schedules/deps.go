@@ -9,12 +9,14 @@ import (
 
 type UserTokensRepo interface {
 	Get(ctx context.Context, isu int64) (*entities.UserTokens, error)
-	UpsertUserTokens(ctx context.Context, tokens *entities.UserTokens) error
+	UpdateUserTokens(ctx context.Context, tokens *entities.UserTokens) (bool, error)
+	FindExpiring(ctx context.Context, before time.Time, limit int) ([]int64, error)
+	WithRefreshLock(ctx context.Context, isu int64, fn func(ctx context.Context) error) error
 }
 
 type Tokens interface {
-	Get(ctx context.Context, isu int64, password string) (*entities.UserTokens, error)
 	Refresh(ctx context.Context, isu int64, refreshToken string) (*entities.UserTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
 }
 
 type ScheduleRepo interface {
@@ -2,11 +2,13 @@ package schedules
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/hexarchy/itmo-calendar/internal/entities"
 
 	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
 )
 
 // Service provides schedule-related operations.
@@ -14,6 +16,10 @@ type Service struct {
 	schedule   ScheduleRepo
 	tokens     Tokens
 	userTokens UserTokensRepo
+
+	// refreshes collapses concurrent refreshes of the same user within the instance,
+	// UserTokensRepo.WithRefreshLock serialises them across instances.
+	refreshes singleflight.Group
 }
 
 // New creates a new Schedule.
@@ -25,16 +31,23 @@ func New(schedule ScheduleRepo, tokens Tokens, userTokens UserTokensRepo) *Servi
 	}
 }
 
-// GetByCreds retrieves schedule using ISU credentials and stores tokens.
-func (s *Service) GetByCreds(ctx context.Context, isu int64, password string, from, to time.Time) ([]entities.DaySchedule, error) {
-	tokens, err := s.tokens.Get(ctx, isu, password)
+// GetByISU retrieves schedule for a user, refreshing tokens if needed.
+// Returns entities.ErrReauthRequired or entities.ErrRefreshTokenRejected if the user has to subscribe again.
+func (s *Service) GetByISU(ctx context.Context, isu int64, from, to time.Time) ([]entities.DaySchedule, error) {
+	tokens, err := s.userTokens.Get(ctx, isu)
 	if err != nil {
-		return nil, errors.Wrap(err, "get tokens")
+		return nil, errors.Wrap(err, "get user tokens")
 	}
 
-	err = s.userTokens.UpsertUserTokens(ctx, tokens)
-	if err != nil {
-		return nil, errors.Wrap(err, "upsert tokens")
+	if tokens == nil {
+		return nil, errors.Wrap(entities.ErrReauthRequired, "user tokens not found")
+	}
+
+	if time.Now().After(tokens.AccessTokenExpiresAt) {
+		tokens, err = s.refresh(ctx, tokens)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	schedule, err := s.schedule.Get(ctx, tokens.AccessToken, from, to)
@@ -45,40 +58,117 @@ func (s *Service) GetByCreds(ctx context.Context, isu int64, password string, fr
 	return schedule, nil
 }
 
-// GetByISU retrieves schedule for a user, refreshing tokens if needed.
-func (s *Service) GetByISU(ctx context.Context, isu int64, from, to time.Time) ([]entities.DaySchedule, error) {
+// Expiring returns up to limit ISUs of active users whose refresh token expires before the time.
+func (s *Service) Expiring(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	isus, err := s.userTokens.FindExpiring(ctx, before, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "find expiring tokens")
+	}
+
+	return isus, nil
+}
+
+// TokensExpireAt returns when the user's ITMO ID refresh token expires, nil if there is none.
+func (s *Service) TokensExpireAt(ctx context.Context, isu int64) (*time.Time, error) {
 	tokens, err := s.userTokens.Get(ctx, isu)
 	if err != nil {
 		return nil, errors.Wrap(err, "get user tokens")
 	}
+	if tokens == nil {
+		return nil, nil
+	}
+
+	return &tokens.RefreshTokenExpiresAt, nil
+}
+
+// RefreshTokens refreshes the user's tokens ahead of their expiry.
+// Returns entities.ErrReauthRequired or entities.ErrRefreshTokenRejected if the user has to subscribe again.
+func (s *Service) RefreshTokens(ctx context.Context, isu int64) error {
+	tokens, err := s.userTokens.Get(ctx, isu)
+	if err != nil {
+		return errors.Wrap(err, "get user tokens")
+	}
 
 	if tokens == nil {
-		return nil, errors.New("user tokens not found")
+		return errors.Wrap(entities.ErrReauthRequired, "user tokens not found")
 	}
 
-	now := time.Now()
-	if now.After(tokens.AccessTokenExpiresAt) {
-		if now.After(tokens.RefreshTokenExpiresAt) {
-			return nil, errors.New("refresh token expired")
-		}
+	_, err = s.refresh(ctx, tokens)
 
-		newTokens, err := s.tokens.Refresh(ctx, isu, tokens.RefreshToken)
-		if err != nil {
-			return nil, errors.Wrap(err, "refresh tokens")
-		}
+	return err
+}
 
-		err = s.userTokens.UpsertUserTokens(ctx, newTokens)
+// refresh refreshes the stale tokens unless another caller already has.
+// ITMO ID rotates refresh tokens, so concurrent refreshes of one user would invalidate each other.
+func (s *Service) refresh(ctx context.Context, stale *entities.UserTokens) (*entities.UserTokens, error) {
+	v, err, _ := s.refreshes.Do(strconv.FormatInt(stale.ISU, 10), func() (any, error) {
+		var refreshed *entities.UserTokens
+		err := s.userTokens.WithRefreshLock(ctx, stale.ISU, func(ctx context.Context) error {
+			current, err := s.userTokens.Get(ctx, stale.ISU)
+			if err != nil {
+				return errors.Wrap(err, "get user tokens")
+			}
+
+			if current == nil {
+				return errors.Wrap(entities.ErrReauthRequired, "user tokens not found")
+			}
+
+			if current.RefreshToken != stale.RefreshToken {
+				refreshed = current
+				return nil
+			}
+
+			refreshed, err = s.rotate(ctx, current)
+			return err
+		})
 		if err != nil {
-			return nil, errors.Wrap(err, "upsert refreshed tokens")
+			return nil, errors.Wrap(err, "with refresh lock")
 		}
 
-		tokens = newTokens
+		return refreshed, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	schedule, err := s.schedule.Get(ctx, tokens.AccessToken, from, to)
+	return v.(*entities.UserTokens), nil
+}
+
+func (s *Service) rotate(ctx context.Context, tokens *entities.UserTokens) (*entities.UserTokens, error) {
+	if time.Now().After(tokens.RefreshTokenExpiresAt) {
+		return nil, errors.Wrap(entities.ErrReauthRequired, "refresh token expired")
+	}
+
+	refreshed, err := s.tokens.Refresh(ctx, tokens.ISU, tokens.RefreshToken)
 	if err != nil {
-		return nil, errors.Wrap(err, "get schedule")
+		return nil, errors.Wrap(err, "refresh tokens")
 	}
 
-	return schedule, nil
+	updated, err := s.userTokens.UpdateUserTokens(ctx, refreshed)
+	if err != nil {
+		return nil, errors.Wrap(err, "update refreshed tokens")
+	}
+	if !updated {
+		return nil, errors.Wrap(entities.ErrUserNotFound, "user tokens deleted")
+	}
+
+	return refreshed, nil
+}
+
+// RevokeTokens revokes the user's stored refresh token at ITMO ID. Returns false if there is none.
+func (s *Service) RevokeTokens(ctx context.Context, isu int64) (bool, error) {
+	tokens, err := s.userTokens.Get(ctx, isu)
+	if err != nil {
+		return false, errors.Wrap(err, "get user tokens")
+	}
+	if tokens == nil {
+		return false, nil
+	}
+
+	err = s.tokens.Logout(ctx, tokens.RefreshToken)
+	if err != nil {
+		return false, errors.Wrap(err, "logout")
+	}
+
+	return true, nil
 }
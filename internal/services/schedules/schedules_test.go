@@ -0,0 +1,118 @@
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// sharedTokens is a token store shared by several instances, its lock stands in for the advisory lock.
+type sharedTokens struct {
+	lock   sync.Mutex
+	mu     sync.Mutex
+	tokens entities.UserTokens
+}
+
+func (r *sharedTokens) Get(_ context.Context, _ int64) (*entities.UserTokens, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tokens := r.tokens
+	return &tokens, nil
+}
+
+func (r *sharedTokens) UpdateUserTokens(_ context.Context, tokens *entities.UserTokens) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens = *tokens
+	return true, nil
+}
+
+func (r *sharedTokens) FindExpiring(_ context.Context, _ time.Time, _ int) ([]int64, error) {
+	return nil, nil
+}
+
+func (r *sharedTokens) WithRefreshLock(ctx context.Context, _ int64, fn func(ctx context.Context) error) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return fn(ctx)
+}
+
+// rotatingProvider rejects reused refresh tokens like ITMO ID does.
+type rotatingProvider struct {
+	mu      sync.Mutex
+	current string
+	calls   atomic.Int32
+}
+
+func (p *rotatingProvider) Refresh(_ context.Context, isu int64, refreshToken string) (*entities.UserTokens, error) {
+	p.calls.Add(1)
+	time.Sleep(10 * time.Millisecond)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if refreshToken != p.current {
+		return nil, entities.ErrRefreshTokenRejected
+	}
+	p.current = fmt.Sprintf("refresh-%d", p.calls.Load())
+
+	return &entities.UserTokens{
+		ISU:                   isu,
+		AccessToken:           "access-" + p.current,
+		RefreshToken:          p.current,
+		AccessTokenExpiresAt:  time.Now().Add(time.Hour),
+		RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+	}, nil
+}
+
+func (p *rotatingProvider) Logout(_ context.Context, _ string) error {
+	return nil
+}
+
+type staticSchedule struct{}
+
+func (staticSchedule) Get(_ context.Context, _ string, _, _ time.Time) ([]entities.DaySchedule, error) {
+	return nil, nil
+}
+
+func TestGetByISUSingleFlight(t *testing.T) {
+	ctx := context.Background()
+	store := &sharedTokens{tokens: entities.UserTokens{
+		ISU:                   1,
+		AccessToken:           "access-refresh-0",
+		RefreshToken:          "refresh-0",
+		AccessTokenExpiresAt:  time.Now().Add(-time.Minute),
+		RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+	}}
+	provider := &rotatingProvider{current: "refresh-0"}
+	instances := []*Service{
+		New(staticSchedule{}, provider, store),
+		New(staticSchedule{}, provider, store),
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := instances[i%len(instances)].GetByISU(ctx, 1, time.Now(), time.Now())
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), provider.calls.Load())
+	assert.Equal(t, "refresh-1", store.tokens.RefreshToken)
+}
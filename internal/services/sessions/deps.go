@@ -0,0 +1,24 @@
+package sessions
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/pkg/jwt"
+)
+
+type Repo interface {
+	Create(ctx context.Context, session entities.Session) error
+	Get(ctx context.Context, id uuid.UUID) (*entities.Session, error)
+	RotateRefresh(ctx context.Context, id, current, next uuid.UUID, expiresAt time.Time) (bool, error)
+	Revoke(ctx context.Context, id uuid.UUID) (bool, error)
+	RevokeAll(ctx context.Context, isu int64) (int64, error)
+}
+
+type Signer interface {
+	Sign(claims jwt.Claimer) (string, error)
+	Verify(token string, claims jwt.Claimer) error
+}
@@ -0,0 +1,217 @@
+package sessions
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/pkg/jwt"
+)
+
+const (
+	_typeAccess  = "access"
+	_typeRefresh = "refresh"
+)
+
+// ErrInvalidSession is returned when a token is malformed, expired or its session is revoked.
+var ErrInvalidSession = errors.New("invalid session")
+
+type claims struct {
+	jwt.Claims
+	Session uuid.UUID `json:"sid"`
+	Type    string    `json:"typ"`
+}
+
+// Service issues signed session tokens. Access tokens are short-lived, refresh tokens
+// are single use: every refresh rotates the token, and presenting a rotated one again
+// is taken as theft and revokes the whole session.
+type Service struct {
+	repo       Repo
+	signer     Signer
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+}
+
+func New(repo Repo, signer Signer, accessTTL, refreshTTL time.Duration) *Service {
+	return &Service{
+		repo:       repo,
+		signer:     signer,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+		now:        time.Now,
+	}
+}
+
+// Create starts a session of the user.
+func (s *Service) Create(ctx context.Context, isu int64) (*entities.SessionTokens, error) {
+	now := s.now()
+	session := entities.Session{
+		ID:        uuid.New(),
+		ISU:       isu,
+		RefreshID: uuid.New(),
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.refreshTTL),
+	}
+
+	err := s.repo.Create(ctx, session)
+	if err != nil {
+		return nil, errors.Wrap(err, "store session")
+	}
+
+	return s.issue(session, now)
+}
+
+// Refresh exchanges a refresh token for new tokens of the same session.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (*entities.SessionTokens, error) {
+	refresh, err := s.verify(refreshToken, _typeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uuid.Parse(refresh.ID)
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+	isu, err := strconv.ParseInt(refresh.Subject, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+
+	now := s.now()
+	session := entities.Session{
+		ID:        refresh.Session,
+		ISU:       isu,
+		RefreshID: uuid.New(),
+		ExpiresAt: now.Add(s.refreshTTL),
+	}
+
+	rotated, err := s.repo.RotateRefresh(ctx, session.ID, current, session.RefreshID, session.ExpiresAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "rotate refresh token")
+	}
+	if !rotated {
+		// The token was already used: whoever holds the newer one may be an attacker.
+		_, err = s.repo.Revoke(ctx, session.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "revoke session")
+		}
+
+		return nil, ErrInvalidSession
+	}
+
+	return s.issue(session, now)
+}
+
+// Authenticate checks an access token and returns its session.
+func (s *Service) Authenticate(ctx context.Context, accessToken string) (*entities.Session, error) {
+	access, err := s.verify(accessToken, _typeAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.repo.Get(ctx, access.Session)
+	if err != nil {
+		return nil, errors.Wrap(err, "get session")
+	}
+	if session == nil || !session.Active(s.now()) {
+		return nil, ErrInvalidSession
+	}
+
+	return session, nil
+}
+
+// Revoke ends the session of the refresh token. With all set every session of its user is ended.
+func (s *Service) Revoke(ctx context.Context, refreshToken string, all bool) error {
+	refresh, err := s.verify(refreshToken, _typeRefresh)
+	if err != nil {
+		return err
+	}
+
+	if !all {
+		_, err = s.repo.Revoke(ctx, refresh.Session)
+		if err != nil {
+			return errors.Wrap(err, "revoke session")
+		}
+
+		return nil
+	}
+
+	session, err := s.repo.Get(ctx, refresh.Session)
+	if err != nil {
+		return errors.Wrap(err, "get session")
+	}
+	if session == nil {
+		return ErrInvalidSession
+	}
+
+	_, err = s.repo.RevokeAll(ctx, session.ISU)
+	if err != nil {
+		return errors.Wrap(err, "revoke sessions")
+	}
+
+	return nil
+}
+
+// RevokeAll ends every session of the user.
+func (s *Service) RevokeAll(ctx context.Context, isu int64) error {
+	_, err := s.repo.RevokeAll(ctx, isu)
+	if err != nil {
+		return errors.Wrap(err, "revoke sessions")
+	}
+
+	return nil
+}
+
+func (s *Service) issue(session entities.Session, now time.Time) (*entities.SessionTokens, error) {
+	tokens := entities.SessionTokens{
+		AccessTokenExpiresAt:  now.Add(s.accessTTL),
+		RefreshTokenExpiresAt: session.ExpiresAt,
+	}
+	subject := strconv.FormatInt(session.ISU, 10)
+
+	var err error
+	tokens.AccessToken, err = s.signer.Sign(&claims{
+		Claims: jwt.Claims{
+			ID:        uuid.NewString(),
+			Subject:   subject,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: tokens.AccessTokenExpiresAt.Unix(),
+		},
+		Session: session.ID,
+		Type:    _typeAccess,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sign access token")
+	}
+
+	tokens.RefreshToken, err = s.signer.Sign(&claims{
+		Claims: jwt.Claims{
+			ID:        session.RefreshID.String(),
+			Subject:   subject,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: tokens.RefreshTokenExpiresAt.Unix(),
+		},
+		Session: session.ID,
+		Type:    _typeRefresh,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sign refresh token")
+	}
+
+	return &tokens, nil
+}
+
+func (s *Service) verify(token, typ string) (*claims, error) {
+	var c claims
+	err := s.signer.Verify(token, &c)
+	if err != nil || c.Type != typ {
+		return nil, ErrInvalidSession
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,121 @@
+package sessions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/pkg/jwt"
+)
+
+type memoryRepo struct {
+	sessions map[uuid.UUID]*entities.Session
+}
+
+func (r *memoryRepo) Create(_ context.Context, session entities.Session) error {
+	r.sessions[session.ID] = &session
+	return nil
+}
+
+func (r *memoryRepo) Get(_ context.Context, id uuid.UUID) (*entities.Session, error) {
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (r *memoryRepo) RotateRefresh(_ context.Context, id, current, next uuid.UUID, expiresAt time.Time) (bool, error) {
+	session, ok := r.sessions[id]
+	if !ok || session.RevokedAt != nil || session.RefreshID != current {
+		return false, nil
+	}
+	session.RefreshID = next
+	session.ExpiresAt = expiresAt
+	return true, nil
+}
+
+func (r *memoryRepo) Revoke(_ context.Context, id uuid.UUID) (bool, error) {
+	session, ok := r.sessions[id]
+	if !ok || session.RevokedAt != nil {
+		return false, nil
+	}
+	now := time.Now()
+	session.RevokedAt = &now
+	return true, nil
+}
+
+func (r *memoryRepo) RevokeAll(_ context.Context, isu int64) (int64, error) {
+	var revoked int64
+	for _, session := range r.sessions {
+		if session.ISU == isu && session.RevokedAt == nil {
+			now := time.Now()
+			session.RevokedAt = &now
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+func newService() *Service {
+	repo := &memoryRepo{sessions: map[uuid.UUID]*entities.Session{}}
+	return New(repo, jwt.NewSigner("k1", "secret", nil), 15*time.Minute, 24*time.Hour)
+}
+
+func TestSessions(t *testing.T) {
+	ctx := context.Background()
+	s := newService()
+
+	tokens, err := s.Create(ctx, 42)
+	require.NoError(t, err)
+
+	session, err := s.Authenticate(ctx, tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), session.ISU)
+
+	_, err = s.Authenticate(ctx, tokens.RefreshToken)
+	assert.ErrorIs(t, err, ErrInvalidSession, "refresh token is not an access token")
+
+	refreshed, err := s.Refresh(ctx, tokens.RefreshToken)
+	require.NoError(t, err)
+	_, err = s.Authenticate(ctx, refreshed.AccessToken)
+	require.NoError(t, err)
+
+	// Reusing the rotated refresh token revokes the session.
+	_, err = s.Refresh(ctx, tokens.RefreshToken)
+	assert.ErrorIs(t, err, ErrInvalidSession)
+	_, err = s.Authenticate(ctx, refreshed.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidSession)
+	_, err = s.Refresh(ctx, refreshed.RefreshToken)
+	assert.ErrorIs(t, err, ErrInvalidSession)
+}
+
+func TestSessionsRevoke(t *testing.T) {
+	ctx := context.Background()
+	s := newService()
+
+	first, err := s.Create(ctx, 42)
+	require.NoError(t, err)
+	second, err := s.Create(ctx, 42)
+	require.NoError(t, err)
+	other, err := s.Create(ctx, 7)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Revoke(ctx, first.RefreshToken, false))
+	_, err = s.Authenticate(ctx, first.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidSession)
+	_, err = s.Authenticate(ctx, second.AccessToken)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Revoke(ctx, second.RefreshToken, true))
+	_, err = s.Authenticate(ctx, second.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidSession)
+	_, err = s.Authenticate(ctx, other.AccessToken)
+	require.NoError(t, err)
+}
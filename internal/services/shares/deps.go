@@ -0,0 +1,14 @@
+package shares
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Repo interface {
+	Insert(ctx context.Context, isu int64, scope entities.ShareScope, hash string) (bool, error)
+	Replace(ctx context.Context, isu int64, scope entities.ShareScope, hash string) error
+	Delete(ctx context.Context, isu int64, scope entities.ShareScope) (bool, error)
+	FindISU(ctx context.Context, scope entities.ShareScope, hash string) (*int64, error)
+}
@@ -0,0 +1,119 @@
+package shares
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+const _tokenBytes = 32
+
+// ErrInvalidToken is returned when a share token is missing, revoked or belongs to another user.
+var ErrInvalidToken = errors.New("invalid share token")
+
+// Service issues and checks share tokens. Only hashes are stored,
+// a token can not be shown again once issued.
+type Service struct {
+	repo Repo
+}
+
+func New(repo Repo) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// Issue issues a token of the scope if the user has none yet.
+// Returns an empty token if they have, it keeps working.
+func (s *Service) Issue(ctx context.Context, isu int64, scope entities.ShareScope) (string, error) {
+	token, err := generate()
+	if err != nil {
+		return "", err
+	}
+
+	inserted, err := s.repo.Insert(ctx, isu, scope, hash(token))
+	if err != nil {
+		return "", errors.Wrap(err, "store share token")
+	}
+	if !inserted {
+		return "", nil
+	}
+
+	return token, nil
+}
+
+// Rotate issues a new token of the scope, the previous one stops working.
+func (s *Service) Rotate(ctx context.Context, isu int64, scope entities.ShareScope) (string, error) {
+	token, err := generate()
+	if err != nil {
+		return "", err
+	}
+
+	err = s.repo.Replace(ctx, isu, scope, hash(token))
+	if err != nil {
+		return "", errors.Wrap(err, "store share token")
+	}
+
+	return token, nil
+}
+
+// Revoke removes the token of the scope. Returns false if there was none.
+func (s *Service) Revoke(ctx context.Context, isu int64, scope entities.ShareScope) (bool, error) {
+	deleted, err := s.repo.Delete(ctx, isu, scope)
+	if err != nil {
+		return false, errors.Wrap(err, "delete share token")
+	}
+
+	return deleted, nil
+}
+
+// Resolve returns the user the token of the scope was issued to.
+func (s *Service) Resolve(ctx context.Context, scope entities.ShareScope, token string) (int64, error) {
+	if token == "" {
+		return 0, ErrInvalidToken
+	}
+
+	isu, err := s.repo.FindISU(ctx, scope, hash(token))
+	if err != nil {
+		return 0, errors.Wrap(err, "find share token")
+	}
+	if isu == nil {
+		return 0, ErrInvalidToken
+	}
+
+	return *isu, nil
+}
+
+// Verify checks that the token of the scope was issued to the user.
+func (s *Service) Verify(ctx context.Context, isu int64, scope entities.ShareScope, token string) error {
+	owner, err := s.Resolve(ctx, scope, token)
+	if err != nil {
+		return err
+	}
+	if owner != isu {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
+
+func generate() (string, error) {
+	raw := make([]byte, _tokenBytes)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", errors.Wrap(err, "generate token")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"time"
 
 	"github.com/hexarchy/itmo-calendar/internal/entities"
 )
@@ -9,5 +10,11 @@ import (
 type Repository interface {
 	GetAll(ctx context.Context) ([]entities.User, error)
 	FindByIDs(ctx context.Context, isus []int64) ([]entities.User, error)
+	DeletedSince(ctx context.Context, isus []int64, since time.Time) ([]int64, error)
 	Create(ctx context.Context, isu int64) (*entities.User, error)
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+	UpdateSettings(ctx context.Context, isu int64, settings entities.UserSettings) (bool, error)
+	Delete(ctx context.Context, audit entities.AccountDeletion) (bool, error)
+	SetStatus(ctx context.Context, isu int64, status entities.UserStatus) (bool, error)
+	RecordSync(ctx context.Context, isu int64, ok bool) error
 }
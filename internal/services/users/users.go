@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"time"
 
 	"github.com/hexarchy/itmo-calendar/internal/entities"
 
@@ -42,3 +43,57 @@ func (s *Service) FindByIDs(ctx context.Context, isus []int64) ([]entities.User,
 	}
 	return users, nil
 }
+
+// DeletedSince returns which of the users had an account deleted at or after the time.
+func (s *Service) DeletedSince(ctx context.Context, isus []int64, since time.Time) ([]int64, error) {
+	deleted, err := s.repo.DeletedSince(ctx, isus, since)
+	if err != nil {
+		return nil, errors.Wrap(err, "find deleted users")
+	}
+	return deleted, nil
+}
+
+// Get returns the user with the given ISU or nil if there is none.
+func (s *Service) Get(ctx context.Context, isu int64) (*entities.User, error) {
+	user, err := s.repo.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user")
+	}
+	return user, nil
+}
+
+// UpdateSettings stores user settings and reports whether the user exists.
+func (s *Service) UpdateSettings(ctx context.Context, isu int64, settings entities.UserSettings) (bool, error) {
+	found, err := s.repo.UpdateSettings(ctx, isu, settings)
+	if err != nil {
+		return false, errors.Wrap(err, "update settings")
+	}
+	return found, nil
+}
+
+// Delete removes the user with all their data and leaves the audit record. Reports whether the user existed.
+func (s *Service) Delete(ctx context.Context, audit entities.AccountDeletion) (bool, error) {
+	deleted, err := s.repo.Delete(ctx, audit)
+	if err != nil {
+		return false, errors.Wrap(err, "delete user")
+	}
+	return deleted, nil
+}
+
+// SetStatus changes the user's status and reports whether it changed.
+func (s *Service) SetStatus(ctx context.Context, isu int64, status entities.UserStatus) (bool, error) {
+	changed, err := s.repo.SetStatus(ctx, isu, status)
+	if err != nil {
+		return false, errors.Wrap(err, "set status")
+	}
+	return changed, nil
+}
+
+// RecordSync stores the outcome of syncing the user's schedule.
+func (s *Service) RecordSync(ctx context.Context, isu int64, ok bool) error {
+	err := s.repo.RecordSync(ctx, isu, ok)
+	if err != nil {
+		return errors.Wrap(err, "record sync")
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package authenticate
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Sessions interface {
+	Authenticate(ctx context.Context, accessToken string) (*entities.Session, error)
+}
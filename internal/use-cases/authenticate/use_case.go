@@ -0,0 +1,31 @@
+package authenticate
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	sessions Sessions
+}
+
+func New(sessions Sessions) *UseCase {
+	return &UseCase{
+		sessions: sessions,
+	}
+}
+
+// Execute returns the user the access token was issued to.
+func (u *UseCase) Execute(ctx context.Context, accessToken string) (*entities.User, error) {
+	session, err := u.sessions.Authenticate(ctx, accessToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "authenticate session")
+	}
+
+	return &entities.User{
+		ISU: session.ISU,
+	}, nil
+}
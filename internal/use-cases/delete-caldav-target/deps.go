@@ -0,0 +1,9 @@
+package deletecaldavtarget
+
+import (
+	"context"
+)
+
+type CalDav interface {
+	DeleteTarget(ctx context.Context, isu int64) (bool, error)
+}
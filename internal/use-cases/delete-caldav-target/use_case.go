@@ -0,0 +1,28 @@
+package deletecaldavtarget
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type UseCase struct {
+	calDav CalDav
+}
+
+func New(calDav CalDav) *UseCase {
+	return &UseCase{
+		calDav: calDav,
+	}
+}
+
+// Execute stops pushing the user's calendar to their CalDAV target.
+// Returns false if no target is configured.
+func (u *UseCase) Execute(ctx context.Context, isu int64) (bool, error) {
+	deleted, err := u.calDav.DeleteTarget(ctx, isu)
+	if err != nil {
+		return false, errors.Wrap(err, "delete caldav target")
+	}
+
+	return deleted, nil
+}
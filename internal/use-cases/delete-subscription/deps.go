@@ -0,0 +1,16 @@
+package deletesubscription
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+	Delete(ctx context.Context, audit entities.AccountDeletion) (bool, error)
+}
+
+type Schedules interface {
+	RevokeTokens(ctx context.Context, isu int64) (bool, error)
+}
@@ -0,0 +1,57 @@
+package deletesubscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	users     Users
+	schedules Schedules
+	logger    *zap.Logger
+}
+
+func New(users Users, schedules Schedules, logger *zap.Logger) *UseCase {
+	return &UseCase{
+		users:     users,
+		schedules: schedules,
+		logger:    logger,
+	}
+}
+
+// Execute unsubscribes the user and deletes all their data. Returns false if the user is not subscribed.
+//
+// The ITMO ID refresh token is revoked first, while it is still stored; a failure there does not keep
+// the account. Queued calendar work is dropped by the worker, which skips users deleted after a task
+// was scheduled, and a send already in flight can not bring data back, as calendars, lesson identities
+// and schedule changes are only stored for existing users and refreshed tokens only replace stored ones.
+func (u *UseCase) Execute(ctx context.Context, isu int64) (bool, error) {
+	user, err := u.users.Get(ctx, isu)
+	if err != nil {
+		return false, errors.Wrap(err, "get user")
+	}
+	if user == nil {
+		return false, nil
+	}
+
+	revoked, err := u.schedules.RevokeTokens(ctx, isu)
+	if err != nil {
+		u.logger.Warn("failed to revoke ITMO tokens of deleted user", zap.Error(err), zap.Int64("isu", isu))
+	}
+
+	deleted, err := u.users.Delete(ctx, entities.AccountDeletion{
+		ISU:            isu,
+		ProviderLogout: revoked,
+		DeletedAt:      time.Now(),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "delete user")
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,74 @@
+package findmeetingslots
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+var (
+	// ErrNoConsent is returned when a share token was issued by someone else than the given participant.
+	ErrNoConsent = errors.New("participant has not shared availability")
+	// ErrNoSchedule is returned when a participant's calendar has not been generated yet.
+	ErrNoSchedule = errors.New("participant has no schedule")
+)
+
+type UseCase struct {
+	shares   Shares
+	calDav   CalDav
+	iCal     ICal
+	meetings Meetings
+}
+
+func New(shares Shares, calDav CalDav, iCal ICal, meetings Meetings) *UseCase {
+	return &UseCase{
+		shares:   shares,
+		calDav:   calDav,
+		iCal:     iCal,
+		meetings: meetings,
+	}
+}
+
+// Execute returns the slots all participants are free in. Every participant has to be identified
+// by their free/busy share token, schedules are read from the stored calendars only.
+func (u *UseCase) Execute(ctx context.Context, participants []entities.MeetingParticipant, query entities.MeetingQuery) ([]entities.MeetingSlot, error) {
+	seen := make(map[int64]bool, len(participants))
+	schedules := make([][]entities.DaySchedule, 0, len(participants))
+
+	for _, participant := range participants {
+		isu, err := u.shares.Resolve(ctx, entities.ShareScopeFreeBusy, participant.Token)
+		if err != nil {
+			return nil, errors.Wrap(err, "resolve share token")
+		}
+		if participant.ISU != 0 && participant.ISU != isu {
+			return nil, errors.Wrapf(ErrNoConsent, "isu %d", participant.ISU)
+		}
+		if seen[isu] {
+			continue
+		}
+		seen[isu] = true
+
+		calDav, err := u.calDav.Get(ctx, isu)
+		if err != nil {
+			return nil, errors.Wrap(err, "get caldav")
+		}
+		if calDav.ICal == nil {
+			return nil, errors.Wrapf(ErrNoSchedule, "isu %d", isu)
+		}
+
+		schedule, err := u.iCal.Parse(ctx, calDav.ICal)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse calendar")
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	slots, err := u.meetings.Slots(schedules, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "find slots")
+	}
+
+	return slots, nil
+}
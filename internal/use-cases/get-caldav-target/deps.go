@@ -0,0 +1,15 @@
+package getcaldavtarget
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+}
+
+type CalDav interface {
+	GetTarget(ctx context.Context, isu int64) (*entities.CalDavTarget, error)
+}
@@ -0,0 +1,40 @@
+package getcaldavtarget
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	users  Users
+	calDav CalDav
+}
+
+func New(users Users, calDav CalDav) *UseCase {
+	return &UseCase{
+		users:  users,
+		calDav: calDav,
+	}
+}
+
+// Execute returns the user's CalDAV target, nil if none is configured.
+// Returns false if the user is not subscribed.
+func (u *UseCase) Execute(ctx context.Context, isu int64) (*entities.CalDavTarget, bool, error) {
+	user, err := u.users.Get(ctx, isu)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "get user")
+	}
+	if user == nil {
+		return nil, false, nil
+	}
+
+	target, err := u.calDav.GetTarget(ctx, isu)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "get caldav target")
+	}
+
+	return target, true, nil
+}
@@ -0,0 +1,17 @@
+package getcalendarcollection
+
+import (
+	"context"
+
+	ics "github.com/arran4/golang-ical"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type CalDav interface {
+	Get(ctx context.Context, isu int64) (entities.CalDav, error)
+}
+
+type ICal interface {
+	Collection(cal *ics.Calendar) entities.CalendarCollection
+}
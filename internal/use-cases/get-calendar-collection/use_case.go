@@ -0,0 +1,37 @@
+package getcalendarcollection
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	calDav CalDav
+	ical   ICal
+}
+
+func New(calDav CalDav, ical ICal) *UseCase {
+	return &UseCase{
+		calDav: calDav,
+		ical:   ical,
+	}
+}
+
+// Execute returns the user's calendar split into CalDAV objects, nil if it has not been generated yet.
+func (u *UseCase) Execute(ctx context.Context, isu int64) (*entities.CalendarCollection, error) {
+	caldav, err := u.calDav.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get caldav")
+	}
+	if caldav.ICal == nil {
+		return nil, nil
+	}
+
+	collection := u.ical.Collection(caldav.ICal)
+	collection.ISU = isu
+
+	return &collection, nil
+}
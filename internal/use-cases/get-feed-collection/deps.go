@@ -0,0 +1,21 @@
+package getfeedcollection
+
+import (
+	"context"
+
+	ics "github.com/arran4/golang-ical"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Shares interface {
+	Resolve(ctx context.Context, scope entities.ShareScope, token string) (int64, error)
+}
+
+type CalDav interface {
+	Get(ctx context.Context, isu int64) (entities.CalDav, error)
+}
+
+type ICal interface {
+	Collection(cal *ics.Calendar) entities.CalendarCollection
+}
@@ -0,0 +1,45 @@
+package getfeedcollection
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	shares Shares
+	calDav CalDav
+	ical   ICal
+}
+
+func New(shares Shares, calDav CalDav, ical ICal) *UseCase {
+	return &UseCase{
+		shares: shares,
+		calDav: calDav,
+		ical:   ical,
+	}
+}
+
+// Execute returns the calendar of the owner of the feed token split into CalDAV objects,
+// nil if it has not been generated yet.
+func (u *UseCase) Execute(ctx context.Context, token string) (*entities.CalendarCollection, error) {
+	isu, err := u.shares.Resolve(ctx, entities.ShareScopeFeed, token)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve feed token")
+	}
+
+	caldav, err := u.calDav.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get caldav")
+	}
+	if caldav.ICal == nil {
+		return nil, nil
+	}
+
+	collection := u.ical.Collection(caldav.ICal)
+	collection.ISU = isu
+
+	return &collection, nil
+}
@@ -0,0 +1,15 @@
+package getfeed
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Shares interface {
+	Resolve(ctx context.Context, scope entities.ShareScope, token string) (int64, error)
+}
+
+type CalDav interface {
+	Get(ctx context.Context, isu int64) (entities.CalDav, error)
+}
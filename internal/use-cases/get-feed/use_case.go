@@ -0,0 +1,40 @@
+package getfeed
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	shares Shares
+	calDav CalDav
+}
+
+func New(shares Shares, calDav CalDav) *UseCase {
+	return &UseCase{
+		shares: shares,
+		calDav: calDav,
+	}
+}
+
+// Execute returns the calendar of the owner of the feed token with its validators,
+// nil if it has not been generated yet.
+func (u *UseCase) Execute(ctx context.Context, token string) (*entities.CalDav, error) {
+	isu, err := u.shares.Resolve(ctx, entities.ShareScopeFeed, token)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve feed token")
+	}
+
+	calDav, err := u.calDav.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get caldav")
+	}
+	if calDav.ICal == nil {
+		return nil, nil
+	}
+
+	return &calDav, nil
+}
@@ -0,0 +1,22 @@
+package getfreebusy
+
+import (
+	"context"
+
+	ics "github.com/arran4/golang-ical"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Shares interface {
+	Verify(ctx context.Context, isu int64, scope entities.ShareScope, token string) error
+}
+
+type CalDav interface {
+	Get(ctx context.Context, isu int64) (entities.CalDav, error)
+}
+
+type ICal interface {
+	Parse(ctx context.Context, cal *ics.Calendar) ([]entities.DaySchedule, error)
+	FreeBusy(fb entities.FreeBusy) *ics.Calendar
+}
@@ -0,0 +1,82 @@
+package getfreebusy
+
+import (
+	"context"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+const (
+	_defaultRange = 14 * 24 * time.Hour
+	_maxRange     = 92 * 24 * time.Hour
+)
+
+// ErrInvalidRange is returned when the requested range is empty or too long.
+var ErrInvalidRange = errors.New("invalid range")
+
+type UseCase struct {
+	shares Shares
+	calDav CalDav
+	iCal   ICal
+}
+
+func New(shares Shares, calDav CalDav, iCal ICal) *UseCase {
+	return &UseCase{
+		shares: shares,
+		calDav: calDav,
+		iCal:   iCal,
+	}
+}
+
+// Query is a request for the availability of a user.
+type Query struct {
+	ISU   int64
+	Token string
+	// From defaults to now and To to two weeks after From.
+	From      *time.Time
+	To        *time.Time
+	PadBefore time.Duration
+	PadAfter  time.Duration
+}
+
+// Execute returns the user's busy periods computed from the stored calendar, both as data and
+// as a VFREEBUSY calendar. Returns nil if the calendar has not been generated yet.
+func (u *UseCase) Execute(ctx context.Context, query Query) (*entities.FreeBusy, *ics.Calendar, error) {
+	from := time.Now().UTC().Truncate(time.Minute)
+	if query.From != nil {
+		from = *query.From
+	}
+	to := from.Add(_defaultRange)
+	if query.To != nil {
+		to = *query.To
+	}
+	if !to.After(from) || to.Sub(from) > _maxRange {
+		return nil, nil, errors.Wrapf(ErrInvalidRange, "%s - %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	err := u.shares.Verify(ctx, query.ISU, entities.ShareScopeFreeBusy, query.Token)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "verify share token")
+	}
+
+	calDav, err := u.calDav.Get(ctx, query.ISU)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get caldav")
+	}
+	if calDav.ICal == nil {
+		return nil, nil, nil
+	}
+
+	schedule, err := u.iCal.Parse(ctx, calDav.ICal)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parse calendar")
+	}
+
+	fb := entities.NewFreeBusy(query.ISU, schedule, from, to, query.PadBefore, query.PadAfter)
+
+	return &fb, u.iCal.FreeBusy(fb), nil
+}
@@ -3,8 +3,9 @@ package getical
 import (
 	"context"
 
-	ics "github.com/arran4/golang-ical"
 	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
 )
 
 type UseCase struct {
@@ -17,11 +18,15 @@ func New(calDav CalDav) *UseCase {
 	}
 }
 
-func (u *UseCase) Execute(ctx context.Context, isu int64) (*ics.Calendar, error) {
+// Execute returns the user's calendar with its validators, nil if it has not been generated yet.
+func (u *UseCase) Execute(ctx context.Context, isu int64) (*entities.CalDav, error) {
 	calDav, err := u.calDav.Get(ctx, isu)
 	if err != nil {
 		return nil, errors.Wrap(err, "get caldav")
 	}
+	if calDav.ICal == nil {
+		return nil, nil
+	}
 
-	return calDav.ICal, nil
+	return &calDav, nil
 }
@@ -0,0 +1,15 @@
+package getreminders
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+}
+
+type Reminders interface {
+	Get(ctx context.Context, isu int64) ([]entities.ReminderRule, error)
+}
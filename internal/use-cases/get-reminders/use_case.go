@@ -0,0 +1,40 @@
+package getreminders
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	users     Users
+	reminders Reminders
+}
+
+func New(users Users, reminders Reminders) *UseCase {
+	return &UseCase{
+		users:     users,
+		reminders: reminders,
+	}
+}
+
+// Execute returns the user's reminder rules.
+// Returns false if the user is not subscribed.
+func (u *UseCase) Execute(ctx context.Context, isu int64) ([]entities.ReminderRule, bool, error) {
+	user, err := u.users.Get(ctx, isu)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "get user")
+	}
+	if user == nil {
+		return nil, false, nil
+	}
+
+	rules, err := u.reminders.Get(ctx, isu)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "get reminders")
+	}
+
+	return rules, true, nil
+}
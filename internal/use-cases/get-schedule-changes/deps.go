@@ -0,0 +1,22 @@
+package getschedulechanges
+
+import (
+	"context"
+
+	ics "github.com/arran4/golang-ical"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type CalDav interface {
+	Get(ctx context.Context, isu int64) (entities.CalDav, error)
+}
+
+type ICal interface {
+	Parse(ctx context.Context, cal *ics.Calendar) ([]entities.DaySchedule, error)
+}
+
+type Changes interface {
+	Token(ctx context.Context, isu int64) (string, error)
+	Since(ctx context.Context, isu int64, token string) (entities.ScheduleChanges, error)
+}
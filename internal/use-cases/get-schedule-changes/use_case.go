@@ -0,0 +1,63 @@
+package getschedulechanges
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/services/changes"
+)
+
+type UseCase struct {
+	calDav  CalDav
+	ical    ICal
+	changes Changes
+}
+
+func New(calDav CalDav, ical ICal, changes Changes) *UseCase {
+	return &UseCase{
+		calDav:  calDav,
+		ical:    ical,
+		changes: changes,
+	}
+}
+
+// Execute returns the schedule changes since the sync token, nil if the user has no calendar yet.
+// Without a token every lesson of the current schedule is returned as added. The token is taken
+// before the calendar is read, so a concurrent refresh is repeated on the next sync rather than lost.
+func (u *UseCase) Execute(ctx context.Context, isu int64, token string) (*entities.ScheduleChanges, error) {
+	var latest string
+	if token == "" {
+		var err error
+		latest, err = u.changes.Token(ctx, isu)
+		if err != nil {
+			return nil, errors.Wrap(err, "get sync token")
+		}
+	}
+
+	caldav, err := u.calDav.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get caldav")
+	}
+	if caldav.ICal == nil {
+		return nil, nil
+	}
+
+	if token != "" {
+		result, err := u.changes.Since(ctx, isu, token)
+		if err != nil {
+			return nil, errors.Wrap(err, "get changes since token")
+		}
+		return &result, nil
+	}
+
+	schedule, err := u.ical.Parse(ctx, caldav.ICal)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse calendar")
+	}
+
+	result := changes.Snapshot(isu, schedule, latest)
+
+	return &result, nil
+}
@@ -24,6 +24,9 @@ func (u *UseCase) Execute(ctx context.Context, isu int64) ([]entities.DaySchedul
 	if err != nil {
 		return nil, errors.Wrap(err, "get caldav")
 	}
+	if caldav.ICal == nil {
+		return nil, nil
+	}
 
 	schedule, err := u.ical.Parse(ctx, caldav.ICal)
 	if err != nil {
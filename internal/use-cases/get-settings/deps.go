@@ -0,0 +1,11 @@
+package getsettings
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+}
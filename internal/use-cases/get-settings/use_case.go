@@ -0,0 +1,32 @@
+package getsettings
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	users Users
+}
+
+func New(users Users) *UseCase {
+	return &UseCase{
+		users: users,
+	}
+}
+
+// Execute returns the user's settings or nil if the user is not subscribed.
+func (u *UseCase) Execute(ctx context.Context, isu int64) (*entities.UserSettings, error) {
+	user, err := u.users.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user")
+	}
+	if user == nil {
+		return nil, nil
+	}
+
+	return &user.Settings, nil
+}
@@ -0,0 +1,16 @@
+package getsyncstatus
+
+import (
+	"context"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+}
+
+type Schedules interface {
+	TokensExpireAt(ctx context.Context, isu int64) (*time.Time, error)
+}
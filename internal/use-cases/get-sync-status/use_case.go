@@ -0,0 +1,50 @@
+package getsyncstatus
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	users     Users
+	schedules Schedules
+}
+
+func New(users Users, schedules Schedules) *UseCase {
+	return &UseCase{
+		users:     users,
+		schedules: schedules,
+	}
+}
+
+// Execute returns how syncing the user's schedule goes, entities.ErrUserNotFound if there is no such user.
+func (u *UseCase) Execute(ctx context.Context, isu int64) (*entities.SyncState, error) {
+	user, err := u.users.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user")
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+
+	expireAt, err := u.schedules.TokensExpireAt(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get tokens expiry")
+	}
+
+	status := user.Status
+	if status == "" {
+		status = entities.UserStatusActive
+	}
+
+	return &entities.SyncState{
+		Status:          status,
+		StatusChangedAt: user.StatusChangedAt,
+		LastSyncedAt:    user.LastSyncedAt,
+		LastFailedAt:    user.LastFailedAt,
+		TokensExpireAt:  expireAt,
+	}, nil
+}
@@ -0,0 +1,9 @@
+package logout
+
+import (
+	"context"
+)
+
+type Sessions interface {
+	Revoke(ctx context.Context, refreshToken string, all bool) error
+}
@@ -0,0 +1,28 @@
+package logout
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type UseCase struct {
+	sessions Sessions
+}
+
+func New(sessions Sessions) *UseCase {
+	return &UseCase{
+		sessions: sessions,
+	}
+}
+
+// Execute revokes the session of the refresh token, or every session of its user if all is set.
+// Access tokens of revoked sessions are rejected right away.
+func (u *UseCase) Execute(ctx context.Context, refreshToken string, all bool) error {
+	err := u.sessions.Revoke(ctx, refreshToken, all)
+	if err != nil {
+		return errors.Wrap(err, "revoke session")
+	}
+
+	return nil
+}
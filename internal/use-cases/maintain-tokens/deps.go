@@ -0,0 +1,21 @@
+package maintaintokens
+
+import (
+	"context"
+	"time"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Schedules interface {
+	Expiring(ctx context.Context, before time.Time, limit int) ([]int64, error)
+	RefreshTokens(ctx context.Context, isu int64) error
+}
+
+type Users interface {
+	SetStatus(ctx context.Context, isu int64, status entities.UserStatus) (bool, error)
+}
+
+type Cron interface {
+	ScheduleSending(ctx context.Context, isus []int64) error
+}
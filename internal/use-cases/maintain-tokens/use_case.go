@@ -0,0 +1,86 @@
+package maintaintokens
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// _batchSize limits users refreshed per run, the rest are left for the next one.
+const _batchSize = 500
+
+type UseCase struct {
+	schedules Schedules
+	users     Users
+	cron      Cron
+	ahead     time.Duration
+	logger    *zap.Logger
+}
+
+func New(schedules Schedules, users Users, cron Cron, ahead time.Duration, logger *zap.Logger) *UseCase {
+	return &UseCase{
+		schedules: schedules,
+		users:     users,
+		cron:      cron,
+		ahead:     ahead,
+		logger:    logger,
+	}
+}
+
+// Execute refreshes ITMO ID tokens of active users before their refresh tokens expire.
+// Users whose tokens can not be refreshed anymore have to subscribe again, their calendars
+// are regenerated to tell them so.
+func (u *UseCase) Execute(ctx context.Context) error {
+	isus, err := u.schedules.Expiring(ctx, time.Now().Add(u.ahead), _batchSize)
+	if err != nil {
+		return errors.Wrap(err, "find expiring tokens")
+	}
+
+	var (
+		refreshed int
+		stopped   []int64
+	)
+	for _, isu := range isus {
+		if ctx.Err() != nil {
+			break
+		}
+
+		err := u.schedules.RefreshTokens(ctx, isu)
+		if err == nil {
+			refreshed++
+			continue
+		}
+
+		status, ok := entities.ReauthStatus(err)
+		if !ok {
+			u.logger.Warn("failed to refresh tokens", zap.Error(err), zap.Int64("isu", isu))
+			continue
+		}
+
+		changed, err := u.users.SetStatus(ctx, isu, status)
+		if err != nil {
+			u.logger.Error("failed to set user status", zap.Error(err), zap.Int64("isu", isu))
+			continue
+		}
+		if changed {
+			stopped = append(stopped, isu)
+		}
+	}
+
+	if len(stopped) > 0 {
+		err = u.cron.ScheduleSending(ctx, stopped)
+		if err != nil {
+			return errors.Wrap(err, "schedule sending")
+		}
+	}
+
+	if refreshed > 0 || len(stopped) > 0 {
+		u.logger.Info("tokens maintained", zap.Int("refreshed", refreshed), zap.Int("reauth_required", len(stopped)))
+	}
+
+	return nil
+}
@@ -26,8 +26,12 @@ func (u *UseCase) Execute(ctx context.Context) error {
 		return errors.Wrap(err, "get all users")
 	}
 
+	// Users who have to subscribe again are not synced, their tokens would only be rejected.
 	isus := make([]int64, 0, len(users))
 	for _, user := range users {
+		if !user.Status.Active() {
+			continue
+		}
 		isus = append(isus, user.ISU)
 	}
 
@@ -0,0 +1,12 @@
+package reencrypttokens
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Keys interface {
+	Stores() []string
+	Reencrypt(ctx context.Context, store string, after int64, limit int) (entities.ReencryptPage, error)
+}
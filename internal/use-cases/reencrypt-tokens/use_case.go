@@ -0,0 +1,64 @@
+package reencrypttokens
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const _batchSize = 100
+
+type UseCase struct {
+	keys   Keys
+	logger *zap.Logger
+}
+
+func New(keys Keys, logger *zap.Logger) *UseCase {
+	return &UseCase{
+		keys:   keys,
+		logger: logger,
+	}
+}
+
+// Execute re-encrypts stored secrets with the current key, walking every stale row of each store once.
+// Rows that can not be decrypted are reported and passed over, they do not hold back the rows after them.
+func (u *UseCase) Execute(ctx context.Context) error {
+	for _, store := range u.keys.Stores() {
+		err := u.reencrypt(ctx, store)
+		if err != nil {
+			return errors.Wrapf(err, "reencrypt %s", store)
+		}
+	}
+
+	return nil
+}
+
+func (u *UseCase) reencrypt(ctx context.Context, store string) error {
+	var (
+		after            int64
+		updated, skipped int
+	)
+	for ctx.Err() == nil {
+		page, err := u.keys.Reencrypt(ctx, store, after, _batchSize)
+		updated += page.Updated
+		skipped += page.Skipped
+		if err != nil {
+			return errors.Wrap(err, "reencrypt batch")
+		}
+		if page.Read < _batchSize {
+			break
+		}
+		after = page.Last
+	}
+
+	if updated > 0 {
+		u.logger.Info("rows re-encrypted with the current key", zap.String("store", store), zap.Int("rows", updated))
+	}
+	if skipped > 0 {
+		u.logger.Warn("rows could not be re-encrypted, their key is unknown or was removed",
+			zap.String("store", store), zap.Int("rows", skipped))
+	}
+
+	return nil
+}
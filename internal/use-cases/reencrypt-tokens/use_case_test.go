@@ -0,0 +1,68 @@
+package reencrypttokens
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// memoryKeys holds stale rows by ISU, rows of unknown keys can not be re-encrypted.
+type memoryKeys struct {
+	stale   map[int64]bool
+	maxISU  int64
+	fetches int
+}
+
+func (k *memoryKeys) Stores() []string {
+	return []string{"user_tokens"}
+}
+
+func (k *memoryKeys) Reencrypt(_ context.Context, _ string, after int64, limit int) (entities.ReencryptPage, error) {
+	k.fetches++
+	page := entities.ReencryptPage{}
+	for isu := after + 1; isu <= k.maxISU && page.Read < limit; isu++ {
+		known, ok := k.stale[isu]
+		if !ok {
+			continue
+		}
+		page.Last = isu
+		page.Read++
+		if !known {
+			page.Skipped++
+			continue
+		}
+		delete(k.stale, isu)
+		page.Updated++
+	}
+
+	return page, nil
+}
+
+func TestExecute(t *testing.T) {
+	// A full batch of rows of a removed key comes first, the rows after it still have to move.
+	keys := &memoryKeys{stale: map[int64]bool{}, maxISU: 250}
+	for isu := int64(1); isu <= 250; isu++ {
+		keys.stale[isu] = isu > _batchSize+20
+	}
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	err := New(keys, zap.New(core)).Execute(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, keys.fetches)
+	for isu, known := range keys.stale {
+		assert.False(t, known, "row %d was not re-encrypted", isu)
+	}
+	assert.Len(t, keys.stale, _batchSize+20)
+
+	skipped := logs.FilterLevelExact(zapcore.WarnLevel).All()
+	require.Len(t, skipped, 1)
+	assert.Equal(t, int64(_batchSize+20), skipped[0].ContextMap()["rows"])
+}
@@ -0,0 +1,11 @@
+package refreshsession
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Sessions interface {
+	Refresh(ctx context.Context, refreshToken string) (*entities.SessionTokens, error)
+}
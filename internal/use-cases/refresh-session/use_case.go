@@ -0,0 +1,29 @@
+package refreshsession
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	sessions Sessions
+}
+
+func New(sessions Sessions) *UseCase {
+	return &UseCase{
+		sessions: sessions,
+	}
+}
+
+// Execute exchanges a refresh token for new session tokens, the old refresh token stops working.
+func (u *UseCase) Execute(ctx context.Context, refreshToken string) (*entities.SessionTokens, error) {
+	tokens, err := u.sessions.Refresh(ctx, refreshToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "refresh session")
+	}
+
+	return tokens, nil
+}
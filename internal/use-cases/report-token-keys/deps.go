@@ -0,0 +1,11 @@
+package reporttokenkeys
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Keys interface {
+	Usage(ctx context.Context) (*entities.KeyUsage, error)
+}
@@ -0,0 +1,29 @@
+package reporttokenkeys
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	keys Keys
+}
+
+func New(keys Keys) *UseCase {
+	return &UseCase{
+		keys: keys,
+	}
+}
+
+// Execute returns how many rows of stored secrets use each encryption key.
+func (u *UseCase) Execute(ctx context.Context) (*entities.KeyUsage, error) {
+	usage, err := u.keys.Usage(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get key usage")
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,11 @@
+package revokesharetoken
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Shares interface {
+	Revoke(ctx context.Context, isu int64, scope entities.ShareScope) (bool, error)
+}
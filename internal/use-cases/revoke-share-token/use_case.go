@@ -0,0 +1,30 @@
+package revokesharetoken
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	shares Shares
+}
+
+func New(shares Shares) *UseCase {
+	return &UseCase{
+		shares: shares,
+	}
+}
+
+// Execute revokes the user's share token of the scope.
+// Returns false if no token was issued.
+func (u *UseCase) Execute(ctx context.Context, isu int64, scope entities.ShareScope) (bool, error) {
+	revoked, err := u.shares.Revoke(ctx, isu, scope)
+	if err != nil {
+		return false, errors.Wrap(err, "revoke share token")
+	}
+
+	return revoked, nil
+}
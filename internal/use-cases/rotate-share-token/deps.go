@@ -0,0 +1,15 @@
+package rotatesharetoken
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+}
+
+type Shares interface {
+	Rotate(ctx context.Context, isu int64, scope entities.ShareScope) (string, error)
+}
@@ -0,0 +1,40 @@
+package rotatesharetoken
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	users  Users
+	shares Shares
+}
+
+func New(users Users, shares Shares) *UseCase {
+	return &UseCase{
+		users:  users,
+		shares: shares,
+	}
+}
+
+// Execute issues a new share token of the scope, invalidating the previous one.
+// Returns an empty token if the user is not subscribed.
+func (u *UseCase) Execute(ctx context.Context, isu int64, scope entities.ShareScope) (string, error) {
+	user, err := u.users.Get(ctx, isu)
+	if err != nil {
+		return "", errors.Wrap(err, "get user")
+	}
+	if user == nil {
+		return "", nil
+	}
+
+	token, err := u.shares.Rotate(ctx, isu, scope)
+	if err != nil {
+		return "", errors.Wrap(err, "rotate share token")
+	}
+
+	return token, nil
+}
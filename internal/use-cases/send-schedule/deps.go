@@ -15,12 +15,33 @@ type Schedules interface {
 
 type Users interface {
 	FindByIDs(ctx context.Context, isus []int64) ([]entities.User, error)
+	DeletedSince(ctx context.Context, isus []int64, since time.Time) ([]int64, error)
+	SetStatus(ctx context.Context, isu int64, status entities.UserStatus) (bool, error)
+	RecordSync(ctx context.Context, isu int64, ok bool) error
 }
 
 type ICal interface {
-	Generate(ctx context.Context, schedule []entities.DaySchedule) (*ics.Calendar, error)
+	Generate(ctx context.Context, schedule []entities.DaySchedule, opts entities.CalendarOptions) (*ics.Calendar, error)
+	CarryCancelled(ctx context.Context, cal, previous *ics.Calendar, schedule []entities.DaySchedule, opts entities.CalendarOptions, since time.Time) ([]string, error)
+	Parse(ctx context.Context, cal *ics.Calendar) ([]entities.DaySchedule, error)
+	Collection(cal *ics.Calendar) entities.CalendarCollection
+}
+
+type Reminders interface {
+	Get(ctx context.Context, isu int64) ([]entities.ReminderRule, error)
+}
+
+type Identities interface {
+	Assign(ctx context.Context, isu int64, schedule []entities.DaySchedule) (map[string]entities.LessonIdentity, error)
+	Cancel(ctx context.Context, identities map[string]entities.LessonIdentity, uids []string) error
 }
 
 type CalDav interface {
 	Create(ctx context.Context, user entities.User, ical *ics.Calendar) error
+	Get(ctx context.Context, isu int64) (entities.CalDav, error)
+	Push(ctx context.Context, isu int64, objects []entities.CalendarObject) (entities.PushResult, error)
+}
+
+type Changes interface {
+	Record(ctx context.Context, isu int64, previous, current []entities.DaySchedule, since time.Time) error
 }
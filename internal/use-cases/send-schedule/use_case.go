@@ -16,31 +16,67 @@ const (
 )
 
 type UseCase struct {
-	schedules Schedules
-	users     Users
-	iCal      ICal
-	calDav    CalDav
-	logger    *zap.Logger
+	schedules  Schedules
+	users      Users
+	iCal       ICal
+	calDav     CalDav
+	identities Identities
+	reminders  Reminders
+	changes    Changes
+	logger     *zap.Logger
 }
 
-func New(schedules Schedules, users Users, iCal ICal, calDav CalDav, logger *zap.Logger) *UseCase {
+func New(schedules Schedules, users Users, iCal ICal, calDav CalDav, identities Identities, reminders Reminders, changes Changes, logger *zap.Logger) *UseCase {
 	return &UseCase{
-		schedules: schedules,
-		users:     users,
-		iCal:      iCal,
-		calDav:    calDav,
-		logger:    logger,
+		schedules:  schedules,
+		users:      users,
+		iCal:       iCal,
+		calDav:     calDav,
+		identities: identities,
+		reminders:  reminders,
+		changes:    changes,
+		logger:     logger,
 	}
 }
 
-func (u *UseCase) Execute(ctx context.Context, isus []int64) error {
+// Execute syncs the users of a task scheduled at the given time. Users whose account was deleted
+// since are skipped even if they subscribed again, the task was meant for the deleted account.
+func (u *UseCase) Execute(ctx context.Context, isus []int64, scheduledAt time.Time) error {
 	users, err := u.users.FindByIDs(ctx, isus)
 	if err != nil {
 		return errors.Wrap(err, "find by ids")
 	}
 
+	deletions, err := u.users.DeletedSince(ctx, isus, scheduledAt)
+	if err != nil {
+		return errors.Wrap(err, "find deleted users")
+	}
+	deleted := make(map[int64]bool, len(deletions))
+	for _, isu := range deletions {
+		deleted[isu] = true
+	}
+
 	for _, user := range users {
+		if deleted[user.ISU] {
+			u.logger.Debug("skipping task scheduled before account deletion", zap.Int64("isu", user.ISU), zap.Time("scheduled_at", scheduledAt))
+			continue
+		}
+
+		if !user.Status.Active() {
+			err := u.processReauth(ctx, user)
+			if err != nil {
+				u.logger.Error("failed to mark calendar for re-subscription", zap.Error(err), zap.Int64("isu", user.ISU))
+			}
+			continue
+		}
+
 		err := u.processSending(ctx, user)
+		u.recordSync(ctx, user.ISU, err == nil)
+
+		if status, ok := entities.ReauthStatus(err); ok {
+			u.logger.Warn("user has to subscribe again", zap.Error(err), zap.Int64("isu", user.ISU), zap.String("status", string(status)))
+			err = u.stopSyncing(ctx, user, status)
+		}
 		if err != nil {
 			u.logger.Error("failed to process sending", zap.Error(err), zap.Int64("isu", user.ISU))
 			continue
@@ -51,6 +87,74 @@ func (u *UseCase) Execute(ctx context.Context, isus []int64) error {
 	return nil
 }
 
+func (u *UseCase) recordSync(ctx context.Context, isu int64, ok bool) {
+	err := u.users.RecordSync(ctx, isu, ok)
+	if err != nil {
+		u.logger.Warn("failed to record sync", zap.Error(err), zap.Int64("isu", isu))
+	}
+}
+
+// stopSyncing changes the status of a user who has to subscribe again and tells them in their calendar.
+func (u *UseCase) stopSyncing(ctx context.Context, user entities.User, status entities.UserStatus) error {
+	_, err := u.users.SetStatus(ctx, user.ISU, status)
+	if err != nil {
+		return errors.Wrap(err, "set status")
+	}
+
+	now := time.Now()
+	user.Status = status
+	user.StatusChangedAt = &now
+
+	return u.processReauth(ctx, user)
+}
+
+// processReauth keeps the last synced lessons of a user who has to subscribe again
+// and adds an event asking them to. ITMO ID is not called.
+func (u *UseCase) processReauth(ctx context.Context, user entities.User) error {
+	previous, err := u.calDav.Get(ctx, user.ISU)
+	if err != nil {
+		return errors.Wrap(err, "get previous calendar")
+	}
+
+	var schedule []entities.DaySchedule
+	if previous.ICal != nil {
+		schedule, err = u.iCal.Parse(ctx, previous.ICal)
+		if err != nil {
+			return errors.Wrap(err, "parse previous calendar")
+		}
+	}
+
+	identities, err := u.identities.Assign(ctx, user.ISU, schedule)
+	if err != nil {
+		return errors.Wrap(err, "assign lesson identities")
+	}
+
+	reminders, err := u.reminders.Get(ctx, user.ISU)
+	if err != nil {
+		return errors.Wrap(err, "get reminders")
+	}
+
+	since := time.Now()
+	if user.StatusChangedAt != nil {
+		since = *user.StatusChangedAt
+	}
+
+	opts := user.CalendarOptions(identities, reminders)
+	opts.ReauthRequiredSince = &since
+
+	ical, err := u.iCal.Generate(ctx, schedule, opts)
+	if err != nil {
+		return errors.Wrap(err, "generate iCal")
+	}
+
+	err = u.calDav.Create(ctx, user, ical)
+	if err != nil {
+		return errors.Wrap(err, "send schedule")
+	}
+
+	return nil
+}
+
 func (u *UseCase) processSending(ctx context.Context, user entities.User) error {
 	from := time.Now().AddDate(0, 0, -_defaultFromTimePeriod)
 	to := time.Now().AddDate(0, 0, _defaultToTimePeriod)
@@ -60,15 +164,66 @@ func (u *UseCase) processSending(ctx context.Context, user entities.User) error
 		return errors.Wrap(err, "get schedule")
 	}
 
-	ical, err := u.iCal.Generate(ctx, schedule)
+	identities, err := u.identities.Assign(ctx, user.ISU, schedule)
+	if err != nil {
+		return errors.Wrap(err, "assign lesson identities")
+	}
+
+	reminders, err := u.reminders.Get(ctx, user.ISU)
+	if err != nil {
+		return errors.Wrap(err, "get reminders")
+	}
+
+	opts := user.CalendarOptions(identities, reminders)
+
+	ical, err := u.iCal.Generate(ctx, schedule, opts)
 	if err != nil {
 		return errors.Wrap(err, "generate iCal")
 	}
 
+	previous, err := u.calDav.Get(ctx, user.ISU)
+	if err != nil {
+		return errors.Wrap(err, "get previous calendar")
+	}
+
+	var previousSchedule []entities.DaySchedule
+	if previous.ICal != nil {
+		previousSchedule, err = u.iCal.Parse(ctx, previous.ICal)
+		if err != nil {
+			return errors.Wrap(err, "parse previous calendar")
+		}
+
+		cancelled, err := u.iCal.CarryCancelled(ctx, ical, previous.ICal, schedule, opts, from)
+		if err != nil {
+			return errors.Wrap(err, "carry cancelled lessons")
+		}
+
+		err = u.identities.Cancel(ctx, identities, cancelled)
+		if err != nil {
+			return errors.Wrap(err, "cancel lesson identities")
+		}
+	}
+
+	// Changes are logged before the calendar they were diffed against is replaced: a failed
+	// save logs them again on the next run, which Coalesce folds, while a failed log after
+	// the save would lose them.
+	err = u.changes.Record(ctx, user.ISU, previousSchedule, schedule, from)
+	if err != nil {
+		return errors.Wrap(err, "record schedule changes")
+	}
+
 	err = u.calDav.Create(ctx, user, ical)
 	if err != nil {
 		return errors.Wrap(err, "send schedule")
 	}
 
+	// The user's own CalDAV server is a best-effort mirror, the feed above stays the source of truth.
+	pushed, err := u.calDav.Push(ctx, user.ISU, u.iCal.Collection(ical).Objects)
+	if err != nil {
+		u.logger.Warn("failed to push schedule to caldav target", zap.Error(err), zap.Int64("isu", user.ISU))
+	} else {
+		u.logger.Debug("schedule pushed to caldav target", zap.Int64("isu", user.ISU), zap.Any("result", pushed))
+	}
+
 	return nil
 }
@@ -0,0 +1,84 @@
+package sendschedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+// memoryUsers holds the current users and when accounts were deleted.
+type memoryUsers struct {
+	users     map[int64]entities.User
+	deletions map[int64]time.Time
+	synced    []int64
+}
+
+func (r *memoryUsers) FindByIDs(_ context.Context, isus []int64) ([]entities.User, error) {
+	var users []entities.User
+	for _, isu := range isus {
+		if user, ok := r.users[isu]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (r *memoryUsers) DeletedSince(_ context.Context, isus []int64, since time.Time) ([]int64, error) {
+	var deleted []int64
+	for _, isu := range isus {
+		if at, ok := r.deletions[isu]; ok && !at.Before(since) {
+			deleted = append(deleted, isu)
+		}
+	}
+	return deleted, nil
+}
+
+func (r *memoryUsers) SetStatus(_ context.Context, _ int64, _ entities.UserStatus) (bool, error) {
+	return true, nil
+}
+
+func (r *memoryUsers) RecordSync(_ context.Context, isu int64, _ bool) error {
+	r.synced = append(r.synced, isu)
+	return nil
+}
+
+// stoppedSchedules records whose schedule was asked for and fails, so the send stops there.
+type stoppedSchedules struct {
+	isus []int64
+}
+
+func (s *stoppedSchedules) GetByISU(_ context.Context, isu int64, _, _ time.Time) ([]entities.DaySchedule, error) {
+	s.isus = append(s.isus, isu)
+	return nil, errors.New("stopped")
+}
+
+func TestExecuteSkipsDeletedAccounts(t *testing.T) {
+	scheduledAt := time.Now().Add(-time.Minute)
+	users := &memoryUsers{
+		users: map[int64]entities.User{
+			1: {ISU: 1, Status: entities.UserStatusActive},
+			2: {ISU: 2, Status: entities.UserStatusActive},
+			3: {ISU: 3, Status: entities.UserStatusActive},
+		},
+		deletions: map[int64]time.Time{
+			// Subscribed again after a deletion long before the task.
+			1: scheduledAt.Add(-time.Hour),
+			// Deleted and subscribed again while the task was queued.
+			2: scheduledAt.Add(time.Second),
+		},
+	}
+	schedules := &stoppedSchedules{}
+
+	err := New(schedules, users, nil, nil, nil, nil, nil, zap.NewNop()).Execute(context.Background(), []int64{1, 2, 3}, scheduledAt)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{1, 3}, schedules.isus)
+	assert.Equal(t, []int64{1, 3}, users.synced)
+}
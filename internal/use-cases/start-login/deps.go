@@ -0,0 +1,11 @@
+package startlogin
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Logins interface {
+	Start(ctx context.Context) (*entities.LoginRequest, error)
+}
@@ -0,0 +1,29 @@
+package startlogin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	logins Logins
+}
+
+func New(logins Logins) *UseCase {
+	return &UseCase{
+		logins: logins,
+	}
+}
+
+// Execute starts a browser login at ITMO ID.
+func (u *UseCase) Execute(ctx context.Context) (*entities.LoginRequest, error) {
+	login, err := u.logins.Start(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "start login")
+	}
+
+	return login, nil
+}
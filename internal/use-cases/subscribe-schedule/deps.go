@@ -10,7 +10,13 @@ import (
 )
 
 type Schedules interface {
-	GetByCreds(ctx context.Context, isu int64, password string, from, to time.Time) ([]entities.DaySchedule, error)
+	GetByISU(ctx context.Context, isu int64, from, to time.Time) ([]entities.DaySchedule, error)
+}
+
+type Logins interface {
+	Complete(ctx context.Context, state, code string) (*entities.UserTokens, error)
+	PasswordLogin(ctx context.Context, isu int64, password string) (*entities.UserTokens, error)
+	Answer(ctx context.Context, challengeID string, answer entities.ChallengeAnswer) (*entities.UserTokens, error)
 }
 
 type Users interface {
@@ -18,9 +24,25 @@ type Users interface {
 }
 
 type ICal interface {
-	Generate(ctx context.Context, schedule []entities.DaySchedule) (*ics.Calendar, error)
+	Generate(ctx context.Context, schedule []entities.DaySchedule, opts entities.CalendarOptions) (*ics.Calendar, error)
+}
+
+type Reminders interface {
+	Get(ctx context.Context, isu int64) ([]entities.ReminderRule, error)
+}
+
+type Identities interface {
+	Assign(ctx context.Context, isu int64, schedule []entities.DaySchedule) (map[string]entities.LessonIdentity, error)
 }
 
 type CalDav interface {
 	Create(ctx context.Context, user entities.User, ical *ics.Calendar) error
 }
+
+type Shares interface {
+	Issue(ctx context.Context, isu int64, scope entities.ShareScope) (string, error)
+}
+
+type Sessions interface {
+	Create(ctx context.Context, isu int64) (*entities.SessionTokens, error)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -11,45 +13,108 @@ import (
 const _period = 120 // days
 
 type UseCase struct {
-	schedules Schedules
-	users     Users
-	iCal      ICal
-	caldav    CalDav
-	logger    *zap.Logger
+	schedules  Schedules
+	logins     Logins
+	users      Users
+	iCal       ICal
+	reminders  Reminders
+	caldav     CalDav
+	identities Identities
+	shares     Shares
+	sessions   Sessions
+	logger     *zap.Logger
 }
 
-func New(schedules Schedules, users Users, iCal ICal, caldav CalDav, logger *zap.Logger) *UseCase {
+func New(schedules Schedules, logins Logins, users Users, iCal ICal, reminders Reminders, caldav CalDav, identities Identities, shares Shares, sessions Sessions, logger *zap.Logger) *UseCase {
 	return &UseCase{
-		schedules: schedules,
-		users:     users,
-		iCal:      iCal,
-		caldav:    caldav,
-		logger:    logger,
+		schedules:  schedules,
+		logins:     logins,
+		users:      users,
+		iCal:       iCal,
+		reminders:  reminders,
+		caldav:     caldav,
+		identities: identities,
+		shares:     shares,
+		sessions:   sessions,
+		logger:     logger,
 	}
 }
-func (u *UseCase) Execute(ctx context.Context, isu int64, password string) error {
+
+// Execute subscribes the user, generates their calendar and starts an API session.
+// The secret feed token is only issued on the first subscription.
+func (u *UseCase) Execute(ctx context.Context, creds entities.Credentials) (*entities.Subscription, error) {
 	from := time.Now().AddDate(0, 0, -30)
 	to := time.Now().AddDate(0, 0, _period)
 
-	schedule, err := u.schedules.GetByCreds(ctx, isu, password, from, to)
+	isu, schedule, err := u.getSchedule(ctx, creds, from, to)
 	if err != nil {
-		return errors.Wrap(err, "get schedule")
+		return nil, err
 	}
 
 	user, err := u.users.Create(ctx, isu)
 	if err != nil {
-		return errors.Wrap(err, "create user")
+		return nil, errors.Wrap(err, "create user")
 	}
 
-	ical, err := u.iCal.Generate(ctx, schedule)
+	identities, err := u.identities.Assign(ctx, isu, schedule)
 	if err != nil {
-		return errors.Wrap(err, "generate iCal")
+		return nil, errors.Wrap(err, "assign lesson identities")
+	}
+
+	reminders, err := u.reminders.Get(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "get reminders")
+	}
+
+	// Re-subscribing rewrites the stored calendar, it has to keep the user's preferences.
+	ical, err := u.iCal.Generate(ctx, schedule, user.CalendarOptions(identities, reminders))
+	if err != nil {
+		return nil, errors.Wrap(err, "generate iCal")
 	}
 
 	err = u.caldav.Create(ctx, *user, ical)
 	if err != nil {
-		return errors.Wrap(err, "send schedule")
+		return nil, errors.Wrap(err, "send schedule")
+	}
+
+	token, err := u.shares.Issue(ctx, isu, entities.ShareScopeFeed)
+	if err != nil {
+		return nil, errors.Wrap(err, "issue feed token")
 	}
 
-	return nil
+	session, err := u.sessions.Create(ctx, isu)
+	if err != nil {
+		return nil, errors.Wrap(err, "create session")
+	}
+
+	return &entities.Subscription{
+		FeedToken: token,
+		Session:   session,
+	}, nil
+}
+
+// getSchedule logs the user in at ITMO ID and fetches their schedule.
+func (u *UseCase) getSchedule(ctx context.Context, creds entities.Credentials, from, to time.Time) (int64, []entities.DaySchedule, error) {
+	tokens, err := u.login(ctx, creds)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "log in")
+	}
+
+	schedule, err := u.schedules.GetByISU(ctx, tokens.ISU, from, to)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "get schedule")
+	}
+
+	return tokens.ISU, schedule, nil
+}
+
+func (u *UseCase) login(ctx context.Context, creds entities.Credentials) (*entities.UserTokens, error) {
+	switch {
+	case creds.Browser():
+		return u.logins.Complete(ctx, creds.State, creds.Code)
+	case creds.Challenged():
+		return u.logins.Answer(ctx, creds.ChallengeID, creds.Answer)
+	default:
+		return u.logins.PasswordLogin(ctx, creds.ISU, creds.Password)
+	}
 }
@@ -0,0 +1,19 @@
+package updatecaldavtarget
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+}
+
+type CalDav interface {
+	SetTarget(ctx context.Context, target entities.CalDavTarget) error
+}
+
+type Cron interface {
+	ScheduleSending(ctx context.Context, isus []int64) error
+}
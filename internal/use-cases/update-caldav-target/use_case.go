@@ -0,0 +1,51 @@
+package updatecaldavtarget
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	users  Users
+	calDav CalDav
+	cron   Cron
+	logger *zap.Logger
+}
+
+func New(users Users, calDav CalDav, cron Cron, logger *zap.Logger) *UseCase {
+	return &UseCase{
+		users:  users,
+		calDav: calDav,
+		cron:   cron,
+		logger: logger,
+	}
+}
+
+// Execute stores the user's CalDAV target and schedules a push of the calendar to it.
+// Returns false if the user is not subscribed.
+func (u *UseCase) Execute(ctx context.Context, target entities.CalDavTarget) (bool, error) {
+	user, err := u.users.Get(ctx, target.ISU)
+	if err != nil {
+		return false, errors.Wrap(err, "get user")
+	}
+	if user == nil {
+		return false, nil
+	}
+
+	err = u.calDav.SetTarget(ctx, target)
+	if err != nil {
+		return false, errors.Wrap(err, "set caldav target")
+	}
+
+	err = u.cron.ScheduleSending(ctx, []int64{target.ISU})
+	if err != nil {
+		// The calendar is pushed on the next cron run anyway.
+		u.logger.Warn("failed to schedule calendar push", zap.Error(err), zap.Int64("isu", target.ISU))
+	}
+
+	return true, nil
+}
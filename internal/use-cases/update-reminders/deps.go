@@ -0,0 +1,19 @@
+package updatereminders
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	Get(ctx context.Context, isu int64) (*entities.User, error)
+}
+
+type Reminders interface {
+	Replace(ctx context.Context, isu int64, rules []entities.ReminderRule) error
+}
+
+type Cron interface {
+	ScheduleSending(ctx context.Context, isus []int64) error
+}
@@ -0,0 +1,51 @@
+package updatereminders
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type UseCase struct {
+	users     Users
+	reminders Reminders
+	cron      Cron
+	logger    *zap.Logger
+}
+
+func New(users Users, reminders Reminders, cron Cron, logger *zap.Logger) *UseCase {
+	return &UseCase{
+		users:     users,
+		reminders: reminders,
+		cron:      cron,
+		logger:    logger,
+	}
+}
+
+// Execute replaces the user's reminder rules and schedules regeneration of the calendar.
+// Returns false if the user is not subscribed.
+func (u *UseCase) Execute(ctx context.Context, isu int64, rules []entities.ReminderRule) (bool, error) {
+	user, err := u.users.Get(ctx, isu)
+	if err != nil {
+		return false, errors.Wrap(err, "get user")
+	}
+	if user == nil {
+		return false, nil
+	}
+
+	err = u.reminders.Replace(ctx, isu, rules)
+	if err != nil {
+		return false, errors.Wrap(err, "replace reminders")
+	}
+
+	err = u.cron.ScheduleSending(ctx, []int64{isu})
+	if err != nil {
+		// The calendar is regenerated on the next cron run anyway.
+		u.logger.Warn("failed to schedule calendar regeneration", zap.Error(err), zap.Int64("isu", isu))
+	}
+
+	return true, nil
+}
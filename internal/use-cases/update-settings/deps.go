@@ -0,0 +1,19 @@
+package updatesettings
+
+import (
+	"context"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+)
+
+type Users interface {
+	UpdateSettings(ctx context.Context, isu int64, settings entities.UserSettings) (bool, error)
+}
+
+type ICal interface {
+	ValidateTemplates(templates entities.EventTemplates) error
+}
+
+type Cron interface {
+	ScheduleSending(ctx context.Context, isus []int64) error
+}
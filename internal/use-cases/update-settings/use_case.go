@@ -0,0 +1,79 @@
+package updatesettings
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/hexarchy/itmo-calendar/internal/entities"
+	"github.com/hexarchy/itmo-calendar/internal/i18n"
+)
+
+var (
+	// ErrInvalidTimeZone is returned when the time zone is not a known IANA zone.
+	ErrInvalidTimeZone = errors.New("invalid time zone")
+	// ErrInvalidLocale is returned when the locale has no message catalog.
+	ErrInvalidLocale = errors.New("invalid locale")
+)
+
+type UseCase struct {
+	users  Users
+	iCal   ICal
+	cron   Cron
+	logger *zap.Logger
+}
+
+func New(users Users, iCal ICal, cron Cron, logger *zap.Logger) *UseCase {
+	return &UseCase{
+		users:  users,
+		iCal:   iCal,
+		cron:   cron,
+		logger: logger,
+	}
+}
+
+// Execute stores the user's settings and schedules regeneration of the calendar.
+// Returns nil settings if the user is not subscribed.
+func (u *UseCase) Execute(ctx context.Context, isu int64, settings entities.UserSettings) (*entities.UserSettings, error) {
+	if !validTimeZone(settings.TimeZone) {
+		return nil, errors.Wrapf(ErrInvalidTimeZone, "%q", settings.TimeZone)
+	}
+
+	if settings.Locale != "" && !i18n.Supported(settings.Locale) {
+		return nil, errors.Wrapf(ErrInvalidLocale, "%q", settings.Locale)
+	}
+
+	err := u.iCal.ValidateTemplates(settings.Templates)
+	if err != nil {
+		return nil, errors.Wrap(err, "validate templates")
+	}
+
+	found, err := u.users.UpdateSettings(ctx, isu, settings)
+	if err != nil {
+		return nil, errors.Wrap(err, "update settings")
+	}
+	if !found {
+		return nil, nil
+	}
+
+	err = u.cron.ScheduleSending(ctx, []int64{isu})
+	if err != nil {
+		// The calendar is regenerated on the next cron run anyway.
+		u.logger.Warn("failed to schedule calendar regeneration", zap.Error(err), zap.Int64("isu", isu))
+	}
+
+	return &settings, nil
+}
+
+// validTimeZone reports whether the name is an IANA time zone. LoadLocation also accepts ""
+// and "Local", which stand for UTC and the server's own zone.
+func validTimeZone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+
+	return err == nil
+}
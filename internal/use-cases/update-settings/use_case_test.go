@@ -0,0 +1,21 @@
+package updatesettings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidTimeZone(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"Europe/Moscow":    true,
+		"Asia/Vladivostok": true,
+		"UTC":              true,
+		"":                 false,
+		"Local":            false,
+		"Mars/Olympus":     false,
+		"../../etc/passwd": false,
+	} {
+		assert.Equal(t, valid, validTimeZone(name), name)
+	}
+}
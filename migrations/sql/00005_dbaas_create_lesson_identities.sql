@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS lesson_identities (
+    isu BIGINT NOT NULL,
+    slot TEXT NOT NULL,
+    uid TEXT NOT NULL,
+    sequence INTEGER NOT NULL DEFAULT 0,
+    fingerprint TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    modified_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (isu, slot)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS lesson_identities;
+-- +goose StatementEnd
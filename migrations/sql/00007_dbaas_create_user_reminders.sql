@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_reminders (
+    isu BIGINT NOT NULL,
+    position INTEGER NOT NULL,
+    lesson_type TEXT NOT NULL DEFAULT '',
+    format TEXT NOT NULL DEFAULT '',
+    minutes_before INTEGER,
+    PRIMARY KEY (isu, position)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_reminders;
+-- +goose StatementEnd
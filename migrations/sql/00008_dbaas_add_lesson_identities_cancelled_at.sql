@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE lesson_identities ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE lesson_identities DROP COLUMN IF EXISTS cancelled_at;
+-- +goose StatementEnd
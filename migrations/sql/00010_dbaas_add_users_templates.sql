@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS summary_template TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS description_template TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS location_template TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS summary_template,
+    DROP COLUMN IF EXISTS description_template,
+    DROP COLUMN IF EXISTS location_template;
+-- +goose StatementEnd
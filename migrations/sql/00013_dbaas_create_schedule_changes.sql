@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS schedule_changes (
+    id BIGSERIAL PRIMARY KEY,
+    isu BIGINT NOT NULL,
+    uid TEXT NOT NULL,
+    change_type TEXT NOT NULL,
+    date DATE NOT NULL,
+    lesson JSONB,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_schedule_changes_isu_id ON schedule_changes (isu, id);
+
+ALTER TABLE caldav ADD COLUMN IF NOT EXISTS changes_pruned_through BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE caldav DROP COLUMN IF EXISTS changes_pruned_through;
+DROP TABLE IF EXISTS schedule_changes;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS caldav_targets (
+    isu BIGINT PRIMARY KEY,
+    url TEXT NOT NULL,
+    username TEXT NOT NULL,
+    password TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS caldav_target_objects (
+    isu BIGINT NOT NULL REFERENCES caldav_targets (isu) ON DELETE CASCADE,
+    uid TEXT NOT NULL,
+    href TEXT NOT NULL,
+    etag TEXT NOT NULL,
+    hash TEXT NOT NULL,
+    PRIMARY KEY (isu, uid)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS caldav_target_objects;
+DROP TABLE IF EXISTS caldav_targets;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE login_states
+    ADD COLUMN IF NOT EXISTS isu BIGINT,
+    ADD COLUMN IF NOT EXISTS form TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE login_states
+    DROP COLUMN IF EXISTS form,
+    DROP COLUMN IF EXISTS isu;
+-- +goose StatementEnd
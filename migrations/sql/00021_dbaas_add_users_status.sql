@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_synced_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_failed_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_user_tokens_refresh_token_expires_at ON user_tokens(refresh_token_expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_user_tokens_refresh_token_expires_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS last_failed_at,
+    DROP COLUMN IF EXISTS last_synced_at,
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+package jwt
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testClaims struct {
+	Claims
+	Type string `json:"typ"`
+}
+
+func TestSigner(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	old := NewSigner("k1", "old-secret", nil)
+	old.now = func() time.Time { return now }
+
+	token, err := old.Sign(&testClaims{Claims: Claims{Subject: "42", ExpiresAt: now.Add(time.Minute).Unix()}, Type: "access"})
+	require.NoError(t, err)
+
+	var claims testClaims
+	require.NoError(t, old.Verify(token, &claims))
+	assert.Equal(t, "42", claims.Subject)
+	assert.Equal(t, "access", claims.Type)
+
+	// Rotated: the previous key still verifies, new tokens use the new key.
+	rotated := NewSigner("k2", "new-secret", map[string]string{"k1": "old-secret"})
+	rotated.now = old.now
+	require.NoError(t, rotated.Verify(token, &testClaims{}))
+
+	fresh, err := rotated.Sign(&testClaims{Claims: Claims{Subject: "42"}})
+	require.NoError(t, err)
+	assert.ErrorIs(t, old.Verify(fresh, &testClaims{}), ErrUnknownKey)
+
+	// Retired key.
+	retired := NewSigner("k2", "new-secret", nil)
+	assert.ErrorIs(t, retired.Verify(token, &testClaims{}), ErrUnknownKey)
+
+	// Tampered payload.
+	parts := strings.Split(token, ".")
+	forged, err := encode(testClaims{Claims: Claims{Subject: "43", ExpiresAt: now.Add(time.Minute).Unix()}})
+	require.NoError(t, err)
+	assert.ErrorIs(t, old.Verify(parts[0]+"."+forged+"."+parts[2], &testClaims{}), ErrInvalidToken)
+	assert.ErrorIs(t, old.Verify("garbage", &testClaims{}), ErrInvalidToken)
+
+	// Expired.
+	old.now = func() time.Time { return now.Add(time.Hour) }
+	assert.ErrorIs(t, old.Verify(token, &testClaims{}), ErrExpired)
+}
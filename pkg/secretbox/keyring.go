@@ -0,0 +1,76 @@
+package secretbox
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const _keySeparator = ":"
+
+// Keyring encrypts with the current key and decrypts with any known one. Encrypted values
+// are prefixed with the ID of their key, values without a prefix belong to the key with an empty ID.
+type Keyring struct {
+	current string
+	boxes   map[string]*Box
+}
+
+// NewKeyring returns a Keyring encrypting with the key of the current ID.
+func NewKeyring(current string, secrets map[string]string) (*Keyring, error) {
+	boxes := make(map[string]*Box, len(secrets))
+	for id, secret := range secrets {
+		if strings.Contains(id, _keySeparator) {
+			return nil, errors.Errorf("key ID %q contains %q", id, _keySeparator)
+		}
+		boxes[id] = New(secret)
+	}
+
+	if _, ok := boxes[current]; !ok {
+		return nil, errors.Errorf("current key %q is not configured", current)
+	}
+
+	return &Keyring{
+		current: current,
+		boxes:   boxes,
+	}, nil
+}
+
+// Current returns the ID of the key new values are encrypted with.
+func (k *Keyring) Current() string {
+	return k.current
+}
+
+// Encrypt encrypts a plaintext string with the current key.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	encrypted, err := k.boxes[k.current].Encrypt(plaintext)
+	if err != nil {
+		return "", err
+	}
+	if k.current == "" {
+		return encrypted, nil
+	}
+
+	return k.current + _keySeparator + encrypted, nil
+}
+
+// Decrypt decrypts a value encrypted with any key of the keyring.
+func (k *Keyring) Decrypt(encrypted string) (string, error) {
+	id := KeyID(encrypted)
+
+	box, ok := k.boxes[id]
+	if !ok {
+		return "", errors.Errorf("unknown key %q", id)
+	}
+
+	return box.Decrypt(strings.TrimPrefix(encrypted, id+_keySeparator))
+}
+
+// KeyID returns the ID of the key the value was encrypted with.
+func KeyID(encrypted string) string {
+	id, _, found := strings.Cut(encrypted, _keySeparator)
+	if !found {
+		return ""
+	}
+
+	return id
+}
@@ -0,0 +1,83 @@
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Box encrypts short secrets with AES-GCM under a key derived from a passphrase.
+type Box struct {
+	key []byte
+}
+
+// New returns a Box keyed with the SHA-256 of the secret.
+func New(secret string) *Box {
+	key := sha256.Sum256([]byte(secret))
+
+	return &Box{
+		key: key[:],
+	}
+}
+
+// Encrypt encrypts a plaintext string, the result is the hex encoded nonce followed by the ciphertext.
+func (b *Box) Encrypt(plaintext string) (string, error) {
+	aesGCM, err := b.gcm()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aesGCM.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.Wrap(err, "generate nonce")
+	}
+
+	ciphertext := aesGCM.Seal(nonce, nonce, []byte(plaintext), nil)
+
+	return hex.EncodeToString(ciphertext), nil
+}
+
+// Decrypt decrypts a string produced by Encrypt.
+func (b *Box) Decrypt(encrypted string) (string, error) {
+	ciphertext, err := hex.DecodeString(encrypted)
+	if err != nil {
+		return "", errors.Wrap(err, "hex decode")
+	}
+
+	aesGCM, err := b.gcm()
+	if err != nil {
+		return "", err
+	}
+
+	nonceSize := aesGCM.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return "", errors.New("ciphertext too short")
+	}
+
+	nonce, ciphertext := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	plaintext, err := aesGCM.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "decrypt")
+	}
+
+	return string(plaintext), nil
+}
+
+func (b *Box) gcm() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(b.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "new cipher")
+	}
+
+	aesGCM, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "new gcm")
+	}
+
+	return aesGCM, nil
+}
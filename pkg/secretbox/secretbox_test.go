@@ -0,0 +1,61 @@
+package secretbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBox(t *testing.T) {
+	box := New("secret")
+
+	encrypted, err := box.Encrypt("password")
+	require.NoError(t, err)
+	assert.NotContains(t, encrypted, "password")
+
+	again, err := box.Encrypt("password")
+	require.NoError(t, err)
+	assert.NotEqual(t, encrypted, again, "nonce must differ")
+
+	decrypted, err := box.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "password", decrypted)
+
+	_, err = New("other").Decrypt(encrypted)
+	assert.Error(t, err)
+}
+
+func TestKeyring(t *testing.T) {
+	legacy, err := New("legacy").Encrypt("password")
+	require.NoError(t, err)
+
+	old, err := NewKeyring("", map[string]string{"": "legacy"})
+	require.NoError(t, err)
+	unprefixed, err := old.Encrypt("password")
+	require.NoError(t, err)
+	assert.Empty(t, KeyID(unprefixed))
+
+	keyring, err := NewKeyring("k2", map[string]string{"": "legacy", "k1": "first", "k2": "second"})
+	require.NoError(t, err)
+
+	encrypted, err := keyring.Encrypt("password")
+	require.NoError(t, err)
+	assert.Equal(t, "k2", KeyID(encrypted))
+
+	for _, value := range []string{legacy, unprefixed, encrypted} {
+		decrypted, err := keyring.Decrypt(value)
+		require.NoError(t, err)
+		assert.Equal(t, "password", decrypted)
+	}
+
+	retired, err := NewKeyring("k2", map[string]string{"k2": "second"})
+	require.NoError(t, err)
+	_, err = retired.Decrypt(legacy)
+	assert.Error(t, err)
+
+	_, err = NewKeyring("k3", map[string]string{"k2": "second"})
+	assert.Error(t, err)
+	_, err = NewKeyring("a:b", map[string]string{"a:b": "second"})
+	assert.Error(t, err)
+}